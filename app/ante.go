@@ -2,6 +2,7 @@ package app
 
 import (
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/evm/ante"
 	evmante "github.com/cosmos/evm/ante"
 	cosmosevmante "github.com/cosmos/evm/ante/evm"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	appante "github.com/lyfeloopinc/lyfebloc-network/app/ante"
+	restakingante "github.com/lyfeloopinc/lyfebloc-network/x/restaking/ante"
 )
 
 // setAnteHandler sets the ante handler for the application.
@@ -36,5 +38,16 @@ func (app *App) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		panic(err)
 	}
 
-	app.SetAnteHandler(appante.NewAnteHandler(options))
+	anteHandler := appante.NewAnteHandler(options)
+	resetPayouts := restakingante.NewResetPayoutsDecorator(*app.RestakingKeeper)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return resetPayouts.AnteHandle(ctx, tx, simulate, anteHandler)
+	})
+}
+
+// setPostHandler sets the post handler for the application.
+func (app *App) setPostHandler() {
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		restakingante.NewCommitWithdrawalsDecorator(*app.RestakingKeeper),
+	))
 }
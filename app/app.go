@@ -61,6 +61,7 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
+	"github.com/ethereum/go-ethereum/common"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
@@ -129,7 +130,7 @@ type App struct {
 
 	// Custom modules
 	LyfeblocnetworkKeeper lyfeblocnetworkmodulekeeper.Keeper
-	RestakingKeeper       restakingkeeper.Keeper
+	RestakingKeeper       *restakingkeeper.Keeper
 	BlocrestakeKeeper     blocrestakemodulekeeper.Keeper
 
 	// Simulation
@@ -243,8 +244,13 @@ func New(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(evmsrvflags.EVMMaxTxGasWanted))
 	app.setAnteHandler(app.txConfig, maxGasWanted)
+	app.setPostHandler()
 	app.setEVMMempool()
 
+	if err := app.setUpgradeHandlers(); err != nil {
+		panic(err)
+	}
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -294,6 +300,19 @@ func (app *App) GetStoreKeysMap() map[string]*storetypes.KVStoreKey {
 	return keys
 }
 
+// BlockedAddresses returns all the app's blocked account addresses.
+func BlockedAddresses() map[string]bool {
+	result := make(map[string]bool)
+	for _, name := range blockAccAddrs {
+		if common.IsHexAddress(name) {
+			result[sdk.AccAddress(common.HexToAddress(name).Bytes()).String()] = true
+			continue
+		}
+		result[authtypes.NewModuleAddress(name).String()] = true
+	}
+	return result
+}
+
 // SimulationManager implements the SimulationApp interface.
 func (app *App) SimulationManager() *module.SimulationManager { return app.sm }
 
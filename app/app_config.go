@@ -95,6 +95,7 @@ var (
 		{Account: erc20types.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: feemarkettypes.ModuleName},
 		{Account: blocrestakemoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+		{Account: restakingtypes.ModuleName},
 	}
 	blockAccAddrs = []string{
 		authtypes.FeeCollectorName,
@@ -103,6 +104,8 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		blocrestakemoduletypes.ModuleName,
+		restakingtypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -228,8 +231,16 @@ var (
 				}),
 			},
 			{
-				Name:   stakingtypes.ModuleName,
-				Config: appconfig.WrapAny(&stakingmodulev1.Module{}),
+				Name: stakingtypes.ModuleName,
+				Config: appconfig.WrapAny(&stakingmodulev1.Module{
+					// restaking has to see a delegation change before
+					// distribution pays its rewards out
+					HooksOrder: []string{
+						restakingtypes.ModuleName,
+						distrtypes.ModuleName,
+						slashingtypes.ModuleName,
+					},
+				}),
 			},
 			{
 				Name:   slashingtypes.ModuleName,
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	blocrestakekeeper "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	blocrestakev2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestBlocrestakeUndelegateFromSlashedValidator(t *testing.T) {
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	val := vals[0]
+	valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+	require.NoError(t, err)
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	// the genesis delegation is not 1:1 to begin with, and a 10% slash lowers
+	// the worth of its shares further
+	tokensBefore := val.Tokens
+	_, err = app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), sdkmath.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	val, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, tokensBefore.MulRaw(9).QuoRaw(10), val.Tokens)
+	delAddr := chain.account.GetAddress()
+	delegation, err := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	delegated := val.TokensFromShares(delegation.Shares).TruncateInt()
+
+	ms := blocrestakekeeper.NewMsgServerV2Impl(app.BlocrestakeKeeper)
+	half := delegated.QuoRaw(2)
+	res, err := ms.Undelegate(ctx, &blocrestakev2.MsgUndelegate{
+		Creator:   delAddr.String(),
+		Delegator: delAddr.String(),
+		Validator: valAddr.String(),
+		Amount:    sdk.NewCoin(bondDenom, half),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, half), res.Amount)
+	require.True(t, res.CompletionTime.After(ctx.BlockTime()))
+
+	// the other half of the delegation is left
+	delegation, err = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	val, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, delegated.Sub(half), val.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// more than what is left is rejected rather than undelegating the wrong amount
+	_, err = ms.Undelegate(ctx, &blocrestakev2.MsgUndelegate{
+		Creator:   delAddr.String(),
+		Delegator: delAddr.String(),
+		Validator: valAddr.String(),
+		Amount:    sdk.NewCoin(bondDenom, delegated),
+	})
+	require.Error(t, err)
+}
+
+func TestBlocrestakeClaimAndRestakeAll(t *testing.T) {
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	// let rewards accrue
+	for i := 0; i < 3; i++ {
+		finalizeAndCommit(t, app, chain)
+	}
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	delAddr := chain.account.GetAddress()
+	balanceBefore := app.BankKeeper.GetBalance(ctx, delAddr, bondDenom)
+
+	res, err := blocrestakekeeper.NewMsgServerV2Impl(app.BlocrestakeKeeper).ClaimAndRestakeAll(ctx, &blocrestakev2.MsgClaimAndRestakeAll{
+		Creator:   delAddr.String(),
+		Delegator: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Restakes, 1)
+	require.Equal(t, vals[0].OperatorAddress, res.Restakes[0].Validator)
+	require.True(t, res.TotalRestaked.IsPositive(), "nothing restaked")
+
+	// the rewards are delegated and the delegator's balance is left as it was
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, vals[0].Tokens.Add(res.TotalRestaked.Amount), val.Tokens)
+	require.Equal(t, balanceBefore, app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+	msg, broken := blocrestakekeeper.AllInvariants(app.BlocrestakeKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestBlocrestakeCancelUnbonding(t *testing.T) {
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	delAddr := chain.account.GetAddress()
+
+	ms := blocrestakekeeper.NewMsgServerV2Impl(app.BlocrestakeKeeper)
+	amount := sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000))
+	_, err = ms.Undelegate(ctx, &blocrestakev2.MsgUndelegate{
+		Creator:   delAddr.String(),
+		Delegator: delAddr.String(),
+		Validator: valAddr.String(),
+		Amount:    amount,
+	})
+	require.NoError(t, err)
+	ubd, err := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+	val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	tokensBefore := val.Tokens
+
+	// cancelling the whole entry delegates it back and removes the unbonding
+	// delegation
+	_, err = ms.CancelUnbonding(ctx, &blocrestakev2.MsgCancelUnbonding{
+		Creator:        delAddr.String(),
+		Delegator:      delAddr.String(),
+		Validator:      valAddr.String(),
+		Amount:         sdk.NewCoin(bondDenom, ubd.Entries[0].Balance),
+		CreationHeight: ubd.Entries[0].CreationHeight,
+	})
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.ErrorIs(t, err, stakingtypes.ErrNoUnbondingDelegation)
+	val, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, tokensBefore.Add(ubd.Entries[0].Balance), val.Tokens)
+	msg, broken := blocrestakekeeper.AllInvariants(app.BlocrestakeKeeper)(ctx)
+	require.False(t, broken, msg)
+}
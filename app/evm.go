@@ -113,6 +113,9 @@ func (app *App) registerEVMModules(appOpts servertypes.AppOptions) error {
 		&app.TransferKeeper,
 	)
 
+	// the restaking keeper is built by depinject before the ERC-20 keeper exists
+	app.RestakingKeeper.SetERC20Keeper(app.Erc20Keeper)
+
 	// register evm modules
 	if err := app.RegisterModules(
 		vm.NewAppModule(app.EVMKeeper, app.AuthKeeper, app.AuthKeeper.AddressCodec()),
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+
+	blocrestakekeeper "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	restakingkeeper "github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+)
+
+const testChainID = "lyfebloc-test"
+
+// testChain is a single validator chain whose genesis account is also the
+// validator's only delegator.
+type testChain struct {
+	validator *cmttypes.Validator
+	privKey   *secp256k1.PrivKey
+	account   *authtypes.BaseAccount
+}
+
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+
+	appOptions := simtestutil.AppOptionsMap{
+		flags.FlagHome:    t.TempDir(),
+		flags.FlagChainID: testChainID,
+	}
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(testChainID))
+
+	// The EVM tx pool follows new blocks on a background goroutine and mistakes
+	// blocks committed faster than it can keep up with for a reorg. The tests
+	// deliver their transactions directly, so it is not needed.
+	require.NoError(t, app.EVMMempool.GetTxPool().Close())
+	return app
+}
+
+func newTestChain(t *testing.T, app *App) (*testChain, GenesisState) {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := cmttypes.NewValidator(pubKey, 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+
+	privKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(privKey.PubKey().Address().Bytes(), privKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000_000))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	// the validator must have signing info to take part in block votes
+	consAddr := sdk.ConsAddress(validator.Address)
+	slashingGenesis := slashingtypes.DefaultGenesisState()
+	slashingGenesis.SigningInfos = []slashingtypes.SigningInfo{{
+		Address:              consAddr.String(),
+		ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0),
+	}}
+	genesisState[slashingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(slashingGenesis)
+
+	return &testChain{validator: validator, privKey: privKey, account: acc}, genesisState
+}
+
+// initTestChain initializes the chain from appState and commits the first block.
+func initTestChain(t *testing.T, app *App, appState json.RawMessage) {
+	t.Helper()
+
+	_, err := app.InitChain(&abci.RequestInitChain{
+		ChainId:         testChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	require.NoError(t, err)
+
+	finalizeAndCommit(t, app, nil)
+}
+
+// finalizeAndCommit executes and commits the next block. When c is set its
+// validator signs the previous block, so that rewards are distributed.
+func finalizeAndCommit(t *testing.T, app *App, c *testChain, txs ...[]byte) *abci.ResponseFinalizeBlock {
+	t.Helper()
+
+	req := &abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1, Txs: txs}
+	if c != nil {
+		req.DecidedLastCommit = abci.CommitInfo{Votes: []abci.VoteInfo{{
+			Validator:   abci.Validator{Address: c.validator.Address, Power: c.validator.VotingPower},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		}}}
+	}
+
+	res, err := app.FinalizeBlock(req)
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	return res
+}
+
+// deliverTx signs msgs with the account of the chain, delivers them in a new
+// block and requires them to succeed.
+func deliverTx(t *testing.T, app *App, c *testChain, msgs ...sdk.Msg) *abci.ExecTxResult {
+	t.Helper()
+
+	acc := app.AuthKeeper.GetAccount(app.BaseApp.NewContext(true), c.account.GetAddress())
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		app.TxConfig(),
+		msgs,
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		testChainID,
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		c.privKey,
+	)
+	require.NoError(t, err)
+	txBytes, err := app.TxConfig().TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := finalizeAndCommit(t, app, c, txBytes)
+	require.Len(t, res.TxResults, 1)
+	require.Zero(t, res.TxResults[0].Code, res.TxResults[0].Log)
+	return res.TxResults[0]
+}
+
+// requireInvariants checks the invariants of the custom modules against the
+// latest committed state.
+func requireInvariants(t testing.TB, app *App) {
+	t.Helper()
+
+	ctx := app.BaseApp.NewContext(true)
+	for _, invariant := range []sdk.Invariant{
+		restakingkeeper.AllInvariants(*app.RestakingKeeper),
+		blocrestakekeeper.AllInvariants(app.BlocrestakeKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
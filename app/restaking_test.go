@@ -0,0 +1,444 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	restakingkeeper "github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	restakingsimulation "github.com/lyfeloopinc/lyfebloc-network/x/restaking/simulation"
+	restakingtypes "github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestWithdrawDelegatorRewardAutoRestakes(t *testing.T) {
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+
+	restakingGenesis := restakingtypes.DefaultGenesis()
+	restakingGenesis.Params.AutoRestakeRatio = sdkmath.LegacyMustNewDecFromStr("0.5")
+	genesisState[restakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(restakingGenesis)
+
+	// accept zero fee transactions
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarketGenesis)
+
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	// let rewards accrue
+	for i := 0; i < 3; i++ {
+		finalizeAndCommit(t, app, chain)
+	}
+
+	delAddr := chain.account.GetAddress()
+	ctx := app.BaseApp.NewContext(true)
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+
+	tokensBefore := vals[0].Tokens
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	// previewing the withdrawal neither pays out nor restakes anything
+	qs := restakingkeeper.NewQueryServer(app.RestakingKeeper)
+	previewReq := &restakingv1.QuerySimulateAutoRestakeRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+	// at height zero distribution sees no rewards on genesis delegations
+	queryCtx := app.BaseApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	preview, err := qs.SimulateAutoRestake(queryCtx, previewReq)
+	require.NoError(t, err)
+	require.True(t, preview.Restaked.IsPositive(), "nothing would be restaked")
+	require.Equal(t, preview.Rewards.AmountOf(bondDenom).QuoRaw(2), preview.Restaked.Amount)
+	again, err := qs.SimulateAutoRestake(queryCtx, previewReq)
+	require.NoError(t, err)
+	require.Equal(t, preview, again)
+	val, err := app.StakingKeeper.GetValidator(queryCtx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, tokensBefore, val.Tokens)
+
+	res := deliverTx(t, app, chain, distributiontypes.NewMsgWithdrawDelegatorReward(delAddr.String(), valAddr.String()))
+	withdrawn := withdrawnRewards(t, res)
+	require.True(t, withdrawn.AmountOf(bondDenom).IsPositive(), "no rewards withdrawn")
+
+	// half of the withdrawn rewards is delegated back to the validator
+	ctx = app.BaseApp.NewContext(true)
+	valAfter, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	restaked := withdrawn.AmountOf(bondDenom).QuoRaw(2)
+	require.Equal(t, tokensBefore.Add(restaked), valAfter.Tokens)
+}
+
+func TestWithdrawDelegatorRewardCarriesOverDust(t *testing.T) {
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+
+	// no withdrawal reaches the minimum, so every restake is carried over
+	restakingGenesis := restakingtypes.DefaultGenesis()
+	restakingGenesis.Params.AutoRestakeRatio = sdkmath.LegacyMustNewDecFromStr("0.5")
+	restakingGenesis.Params.MinRestakeAmount = sdk.DefaultPowerReduction.MulRaw(1_000_000)
+	genesisState[restakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(restakingGenesis)
+
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarketGenesis)
+
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	for i := 0; i < 3; i++ {
+		finalizeAndCommit(t, app, chain)
+	}
+
+	delAddr := chain.account.GetAddress()
+	ctx := app.BaseApp.NewContext(true)
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	res := deliverTx(t, app, chain, distributiontypes.NewMsgWithdrawDelegatorReward(delAddr.String(), valAddr.String()))
+	withdrawn := withdrawnRewards(t, res)
+	require.True(t, withdrawn.AmountOf(bondDenom).IsPositive(), "no rewards withdrawn")
+
+	// half of the withdrawn rewards is held by the module account instead of
+	// being delegated
+	ctx = app.BaseApp.NewContext(true)
+	carryOver, err := app.RestakingKeeper.GetCarryOver(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, withdrawn.AmountOf(bondDenom).QuoRaw(2), carryOver)
+	moduleAddr := app.AuthKeeper.GetModuleAddress(restakingtypes.ModuleName)
+	require.Equal(t, carryOver, app.BankKeeper.GetBalance(ctx, moduleAddr, bondDenom).Amount)
+	valAfter, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, vals[0].Tokens, valAfter.Tokens)
+	requireInvariants(t, app)
+
+	balanceBefore := app.BankKeeper.GetBalance(ctx, delAddr, bondDenom)
+	deliverTx(t, app, chain, &restakingv1.MsgClaimCarryOver{DelegatorAddress: delAddr.String()})
+
+	ctx = app.BaseApp.NewContext(true)
+	require.Equal(t, balanceBefore.AddAmount(carryOver), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+	require.True(t, app.BankKeeper.GetBalance(ctx, moduleAddr, bondDenom).IsZero())
+	requireInvariants(t, app)
+}
+
+// withdrawnRewards returns the rewards paid out by the reward withdrawal in
+// res.
+func withdrawnRewards(t *testing.T, res *abci.ExecTxResult) sdk.Coins {
+	t.Helper()
+
+	var withdrawn sdk.Coins
+	for _, ev := range res.Events {
+		if ev.Type != distributiontypes.EventTypeWithdrawRewards {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if attr.Key == sdk.AttributeKeyAmount {
+				coins, err := sdk.ParseCoinsNormalized(attr.Value)
+				require.NoError(t, err)
+				withdrawn = coins
+			}
+		}
+	}
+	return withdrawn
+}
+
+// TestRandomWithdrawalsKeepDelegationsConsistent delegates from a set of
+// random accounts under randomized restaking parameters and preferences, then
+// sends thousands of random reward withdrawals. Every restake must show up
+// in the staking state and in the restaking statistics alike.
+func TestRandomWithdrawalsKeepDelegationsConsistent(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping restaking simulation in short mode")
+	}
+
+	const (
+		numDelegators = 40
+		numBlocks     = 25
+		minWithdrawal = 2_000
+	)
+
+	r := rand.New(rand.NewSource(42))
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+	delegators := simtypes.RandomAccounts(r, numDelegators)
+
+	// withdrawals are only restaked outside of epoch mode
+	restakingGenesis := restakingtypes.DefaultGenesis()
+	restakingGenesis.Params = restakingsimulation.RandomParams(r)
+	restakingGenesis.Params.EpochIdentifier = ""
+	for _, del := range delegators[:numDelegators/2] {
+		ratio := sdkmath.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+		restakingGenesis.DelegatorPreferences = append(restakingGenesis.DelegatorPreferences, restakingv1.DelegatorPreference{
+			DelegatorAddress: del.Address.String(),
+			Ratio:            &ratio,
+		})
+	}
+	genesisState[restakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(restakingGenesis)
+
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarketGenesis)
+
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	ctx := app.BaseApp.NewContext(true)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+
+	sequences := make(map[string]uint64)
+	signedTx := func(acc simtypes.Account, msgs ...sdk.Msg) []byte {
+		account := app.AuthKeeper.GetAccount(app.BaseApp.NewContext(true), acc.Address)
+		require.NotNil(t, account)
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			app.TxConfig(),
+			msgs,
+			sdk.NewCoins(),
+			2_000_000,
+			testChainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{sequences[acc.Address.String()]},
+			acc.PrivKey,
+		)
+		require.NoError(t, err)
+		sequences[acc.Address.String()]++
+		txBytes, err := app.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+	requireSuccess := func(res *abci.ResponseFinalizeBlock) {
+		for _, txRes := range res.TxResults {
+			require.Zero(t, txRes.Code, txRes.Log)
+		}
+	}
+
+	// fund the delegators, then let each of them delegate a random amount
+	funder := simtypes.Account{PrivKey: chain.privKey, Address: chain.account.GetAddress()}
+	sends := make([]sdk.Msg, 0, numDelegators)
+	for _, del := range delegators {
+		sends = append(sends, banktypes.NewMsgSend(funder.Address, del.Address, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000_000))))
+	}
+	requireSuccess(finalizeAndCommit(t, app, chain, signedTx(funder, sends...)))
+
+	initial := make(map[string]sdkmath.Int, numDelegators)
+	var delegateTxs [][]byte
+	for _, del := range delegators {
+		amount := sdkmath.NewInt(1_000_000 + r.Int63n(1_000_000_000))
+		initial[del.Address.String()] = amount
+		delegateTxs = append(delegateTxs, signedTx(del, stakingtypes.NewMsgDelegate(del.Address.String(), valAddr.String(), sdk.NewCoin(bondDenom, amount))))
+	}
+	requireSuccess(finalizeAndCommit(t, app, chain, delegateTxs...))
+
+	ctx = app.BaseApp.NewContext(true)
+	val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	tokensAfterSetup := val.Tokens
+
+	requireConsistent := func() {
+		ctx := app.BaseApp.NewContext(true)
+		val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+
+		// the validator's shares are exactly those of its delegations
+		delegations, err := app.StakingKeeper.GetValidatorDelegations(ctx, valAddr)
+		require.NoError(t, err)
+		shares := sdkmath.LegacyZeroDec()
+		for _, del := range delegations {
+			shares = shares.Add(del.Shares)
+		}
+		require.Equal(t, val.DelegatorShares, shares)
+
+		// the bonded pool holds exactly the validator's tokens
+		bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+		require.Equal(t, val.Tokens, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+
+		// every token added since the setup was restaked and counted once
+		total, err := app.RestakingKeeper.GetTotalRestaked(ctx)
+		require.NoError(t, err)
+		require.Equal(t, tokensAfterSetup.Add(total), val.Tokens)
+		validatorTotal, err := app.RestakingKeeper.GetValidatorRestaked(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, total, validatorTotal)
+
+		delegatorTotal := sdkmath.ZeroInt()
+		for _, del := range delegators {
+			restaked, err := app.RestakingKeeper.GetDelegatorRestaked(ctx, del.Address)
+			require.NoError(t, err)
+			delegatorTotal = delegatorTotal.Add(restaked)
+
+			delegation, err := app.StakingKeeper.GetDelegation(ctx, del.Address, valAddr)
+			require.NoError(t, err)
+			require.Equal(t, initial[del.Address.String()].Add(restaked), val.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+		require.Equal(t, total, delegatorTotal)
+
+		requireInvariants(t, app)
+	}
+
+	withdrawals := 0
+	for block := 0; block < numBlocks; block++ {
+		var txs [][]byte
+		for _, del := range delegators {
+			switch n := r.Intn(10); {
+			case n < 8:
+				// repeated withdrawals in one transaction pay out only once
+				msgs := make([]sdk.Msg, 1+r.Intn(5))
+				for i := range msgs {
+					msgs[i] = distributiontypes.NewMsgWithdrawDelegatorReward(del.Address.String(), valAddr.String())
+				}
+				withdrawals += len(msgs)
+				txs = append(txs, signedTx(del, msgs...))
+			case n == 8:
+				ratio := sdkmath.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+				txs = append(txs, signedTx(del, &restakingv1.MsgSetDelegatorPreference{
+					DelegatorAddress: del.Address.String(),
+					Ratio:            &ratio,
+				}))
+			}
+		}
+		requireSuccess(finalizeAndCommit(t, app, chain, txs...))
+
+		if block%5 == 0 {
+			requireConsistent()
+		}
+	}
+
+	// drain any restakes deferred by the per-block budget
+	for i := 0; i < 3; i++ {
+		finalizeAndCommit(t, app, chain)
+	}
+	requireConsistent()
+
+	require.GreaterOrEqual(t, withdrawals, minWithdrawal)
+	total, err := app.RestakingKeeper.GetTotalRestaked(app.BaseApp.NewContext(true))
+	require.NoError(t, err)
+	require.True(t, total.IsPositive(), "nothing was restaked")
+}
+
+// initRestakingChain initializes a chain that restakes half of every reward
+// withdrawal and accepts zero fee transactions, and lets rewards accrue.
+func initRestakingChain(t *testing.T) (*App, *testChain, sdk.ValAddress) {
+	t.Helper()
+
+	app := newTestApp(t)
+	chain, genesisState := newTestChain(t, app)
+
+	restakingGenesis := restakingtypes.DefaultGenesis()
+	restakingGenesis.Params.AutoRestakeRatio = sdkmath.LegacyMustNewDecFromStr("0.5")
+	genesisState[restakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(restakingGenesis)
+
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarketGenesis)
+
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	for i := 0; i < 3; i++ {
+		finalizeAndCommit(t, app, chain)
+	}
+
+	vals, err := app.StakingKeeper.GetAllValidators(app.BaseApp.NewContext(true))
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	return app, chain, valAddr
+}
+
+// requireNothingRestaked checks that the validator holds exactly tokens and
+// that nothing was auto-restaked.
+func requireNothingRestaked(t *testing.T, app *App, valAddr sdk.ValAddress, tokens sdkmath.Int) {
+	t.Helper()
+
+	ctx := app.BaseApp.NewContext(true)
+	val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, tokens, val.Tokens)
+	total, err := app.RestakingKeeper.GetTotalRestaked(ctx)
+	require.NoError(t, err)
+	require.True(t, total.IsZero(), "restaked %s", total)
+}
+
+func TestWithdrawToThirdPartyIsNotRestaked(t *testing.T) {
+	app, chain, valAddr := initRestakingChain(t)
+	delAddr := chain.account.GetAddress()
+	thirdParty := sdk.AccAddress(bytes.Repeat([]byte{0x7}, 20))
+
+	ctx := app.BaseApp.NewContext(true)
+	val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, delAddr, bondDenom)
+
+	// the withdraw address changes before the withdrawal executes, so the
+	// rewards are paid to the third party
+	res := deliverTx(t, app, chain,
+		distributiontypes.NewMsgSetWithdrawAddress(delAddr, thirdParty),
+		distributiontypes.NewMsgWithdrawDelegatorReward(delAddr.String(), valAddr.String()),
+	)
+	withdrawn := withdrawnRewards(t, res)
+	require.True(t, withdrawn.AmountOf(bondDenom).IsPositive(), "no rewards withdrawn")
+
+	ctx = app.BaseApp.NewContext(true)
+	require.Equal(t, withdrawn, app.BankKeeper.GetAllBalances(ctx, thirdParty))
+	require.Equal(t, balanceBefore, app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+	requireNothingRestaked(t, app, valAddr, val.Tokens)
+}
+
+func TestDelegationPayoutIsNotRestaked(t *testing.T) {
+	app, chain, valAddr := initRestakingChain(t)
+	delAddr := chain.account.GetAddress()
+
+	ctx := app.BaseApp.NewContext(true)
+	val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	// topping up the delegation pays the rewards out, leaving nothing for
+	// the withdrawal that follows
+	amount := sdk.NewInt64Coin(bondDenom, 1_000_000)
+	res := deliverTx(t, app, chain,
+		stakingtypes.NewMsgDelegate(delAddr.String(), valAddr.String(), amount),
+		distributiontypes.NewMsgWithdrawDelegatorReward(delAddr.String(), valAddr.String()),
+	)
+	require.True(t, withdrawnRewards(t, res).AmountOf(bondDenom).IsZero())
+
+	requireNothingRestaked(t, app, valAddr, val.Tokens.Add(amount.Amount))
+}
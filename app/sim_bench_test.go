@@ -48,6 +48,7 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 		}
 	}
 	appOptions.SetDefault(flags.FlagHome, DefaultNodeHome)
+	appOptions.SetDefault(flags.FlagChainID, SimAppChainID)
 
 	app := New(logger, db, nil, true, appOptions, interBlockCacheOpt(), baseapp.SetChainID(SimAppChainID))
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/feegrant"
@@ -28,6 +29,7 @@ import (
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
@@ -56,6 +58,33 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// feelessAppStateFn returns the randomized simulation genesis with the fee
+// market's base fee turned off, as the simulated transactions pay random fees
+// mostly far below it.
+func feelessAppStateFn(app *App) simulationtypes.AppStateFn {
+	appStateFn := simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis())
+	return func(r *rand.Rand, accs []simulationtypes.Account, config simulationtypes.Config,
+	) (json.RawMessage, []simulationtypes.Account, string, time.Time) {
+		appState, simAccs, chainID, genesisTime := appStateFn(r, accs, config)
+
+		var genesisState GenesisState
+		if err := json.Unmarshal(appState, &genesisState); err != nil {
+			panic(err)
+		}
+		var feemarketGenesis feemarkettypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesisState[feemarkettypes.ModuleName], &feemarketGenesis)
+		feemarketGenesis.Params.NoBaseFee = true
+		feemarketGenesis.Params.MinGasPrice = sdkmath.LegacyZeroDec()
+		genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(&feemarketGenesis)
+
+		appState, err := json.Marshal(genesisState)
+		if err != nil {
+			panic(err)
+		}
+		return appState, simAccs, chainID, genesisTime
+	}
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using ignite command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -83,6 +112,7 @@ func BenchmarkSimulation(b *testing.B) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[flags.FlagChainID] = SimAppChainID
 
 	bApp := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(b, Name, bApp.Name())
@@ -127,19 +157,22 @@ func TestFullAppSimulation(t *testing.T) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[flags.FlagChainID] = SimAppChainID
 
 	app := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	if !simcli.FlagSigverifyTxValue {
 		app.SetNotSigverifyTx()
 	}
 	require.Equal(t, "lyfebloc-network", app.Name())
+	// the simulation delivers its transactions directly, see newTestApp
+	require.NoError(t, app.EVMMempool.GetTxPool().Close())
 
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t,
 		os.Stdout,
 		app.BaseApp,
-		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		feelessAppStateFn(app),
 		simulationtypes.RandomAccounts,
 		simtestutil.BuildSimulationOperations(app, app.AppCodec(), config, app.TxConfig()),
 		BlockedAddresses(),
@@ -151,6 +184,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -174,6 +208,7 @@ func TestAppImportExport(t *testing.T) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[flags.FlagChainID] = SimAppChainID
 
 	bApp := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, Name, bApp.Name())
@@ -294,6 +329,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[flags.FlagChainID] = SimAppChainID
 
 	bApp := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, Name, bApp.Name())
@@ -395,6 +431,7 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 	appOptions.SetDefault(flags.FlagHome, DefaultNodeHome)
+	appOptions.SetDefault(flags.FlagChainID, SimAppChainID)
 	if simcli.FlagVerboseValue {
 		appOptions.SetDefault(flags.FlagLogLevel, "debug")
 	}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/app/upgrades"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/app/upgrades/v2"
+)
+
+// Upgrades are the software upgrades the app can apply, oldest first.
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+}
+
+// setUpgradeHandlers registers the handler of every upgrade and, when the node
+// is started at the height of a planned upgrade, the store loader applying its
+// store upgrades. It must run before the app is loaded.
+func (app *App) setUpgradeHandlers() error {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.ModuleManager, app.Configurator()),
+		)
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return nil
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == upgradeInfo.Name {
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &upgrade.StoreUpgrades))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package upgrades
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Upgrade defines a software upgrade: the handler run at the upgrade height
+// and the stores added, renamed or deleted with it.
+type Upgrade struct {
+	// UpgradeName is the name of the governance upgrade plan.
+	UpgradeName string
+
+	// CreateUpgradeHandler builds the handler run when the plan is applied.
+	CreateUpgradeHandler func(*module.Manager, module.Configurator) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades are applied by the store loader of the first binary
+	// started at the upgrade height.
+	StoreUpgrades storetypes.StoreUpgrades
+}
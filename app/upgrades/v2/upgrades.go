@@ -0,0 +1,30 @@
+package v2
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/lyfeloopinc/lyfebloc-network/app/upgrades"
+)
+
+// UpgradeName is the name of the v2 upgrade plan.
+const UpgradeName = "v2"
+
+// Upgrade moves x/restaking from its v1 store, a single auto-restake ratio, to
+// the params-based layout. No stores are added or removed.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
+
+// CreateUpgradeHandler runs the module migrations registered with the
+// configurator.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
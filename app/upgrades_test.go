@@ -0,0 +1,59 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/lyfeloopinc/lyfebloc-network/app/upgrades/v2"
+	restakingv2 "github.com/lyfeloopinc/lyfebloc-network/x/restaking/migrations/v2"
+	restakingtypes "github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestUpgradeV2MigratesRestakingV1Store(t *testing.T) {
+	app := newTestApp(t)
+	_, genesisState := newTestChain(t, app)
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	initTestChain(t, app, appState)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+
+	// rewrite the restaking store as a v1 node left it, holding nothing but
+	// the auto-restake ratio
+	store := ctx.KVStore(app.GetKey(restakingtypes.StoreKey))
+	var keys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	require.NoError(t, it.Close())
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	ratio := sdkmath.LegacyMustNewDecFromStr("0.4")
+	bz, err := sdk.LegacyDecValue.Encode(ratio)
+	require.NoError(t, err)
+	store.Set(restakingv2.AutoRestakeRatioKey, bz)
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	require.Equal(t, uint64(2), fromVM[restakingtypes.ModuleName])
+	fromVM[restakingtypes.ModuleName] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: ctx.BlockHeight()}))
+
+	expected := restakingtypes.DefaultParams()
+	expected.AutoRestakeRatio = ratio
+	require.Equal(t, expected, app.RestakingKeeper.GetParams(ctx))
+	require.False(t, store.Has(restakingv2.AutoRestakeRatioKey))
+
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), vm[restakingtypes.ModuleName])
+}
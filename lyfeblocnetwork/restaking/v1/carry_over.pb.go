@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/carry_over.proto
+
+package v1
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CarryOver is the bond denom held back from the auto-restakes of a
+// delegation because they fell below min_restake_amount. The module account
+// holds it until it is restaked with a later auto-restake or claimed back by
+// the delegator.
+type CarryOver struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator paying the rewards the amount was
+	// held back from.
+	ValidatorAddress string                `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *CarryOver) Reset()         { *m = CarryOver{} }
+func (m *CarryOver) String() string { return proto.CompactTextString(m) }
+func (*CarryOver) ProtoMessage()    {}
+func (*CarryOver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e1254be3ba35d63, []int{0}
+}
+func (m *CarryOver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CarryOver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CarryOver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CarryOver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarryOver.Merge(m, src)
+}
+func (m *CarryOver) XXX_Size() int {
+	return m.Size()
+}
+func (m *CarryOver) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarryOver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarryOver proto.InternalMessageInfo
+
+func (m *CarryOver) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *CarryOver) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CarryOver)(nil), "lyfeblocnetwork.restaking.v1.CarryOver")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/carry_over.proto", fileDescriptor_0e1254be3ba35d63)
+}
+
+var fileDescriptor_0e1254be3ba35d63 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0xc7, 0x77, 0x0b, 0x04, 0xf7, 0x94, 0x8b, 0x81, 0x49, 0x8d, 0xd5, 0x29, 0x88, 0x9d, 0x41,
+	0x7a, 0x82, 0xb4, 0x0e, 0x5e, 0x0a, 0x0c, 0x3a, 0x74, 0x48, 0xc6, 0xdd, 0x69, 0x1c, 0xdc, 0x9d,
+	0x4f, 0x66, 0xc6, 0x09, 0xdf, 0xa2, 0x87, 0xf1, 0x21, 0x3c, 0x8a, 0xa7, 0xe8, 0x20, 0xa1, 0x87,
+	0x5e, 0x23, 0xdc, 0x59, 0x85, 0x3c, 0x74, 0x9b, 0xf9, 0x7f, 0xff, 0xff, 0xef, 0x83, 0xff, 0x17,
+	0x44, 0xe9, 0xe4, 0x8d, 0xf5, 0x53, 0x88, 0x25, 0x33, 0xef, 0xa0, 0x86, 0x44, 0x31, 0x6d, 0xe8,
+	0x50, 0x48, 0x4e, 0x6c, 0x93, 0xc4, 0x54, 0xa9, 0x49, 0x0f, 0x2c, 0x53, 0x78, 0xa4, 0xc0, 0x40,
+	0x78, 0xba, 0x67, 0xc7, 0x3b, 0x3b, 0xb6, 0xcd, 0xfa, 0x49, 0x0c, 0x3a, 0x03, 0xdd, 0xcb, 0xbd,
+	0xc4, 0x7d, 0x5c, 0xb0, 0x5e, 0xe5, 0xc0, 0xc1, 0xe9, 0x9b, 0x97, 0x53, 0x2f, 0x7f, 0xfc, 0xa0,
+	0xdc, 0xde, 0xec, 0x78, 0xb4, 0x4c, 0x85, 0xf7, 0x41, 0x25, 0x61, 0x29, 0xe3, 0xd4, 0x80, 0xea,
+	0xd1, 0x24, 0x51, 0x4c, 0xeb, 0x9a, 0x7f, 0xee, 0x5f, 0x95, 0x5b, 0xb5, 0xc5, 0x34, 0xaa, 0x16,
+	0xc0, 0x5b, 0x37, 0x79, 0x32, 0x4a, 0x48, 0xde, 0x3d, 0xda, 0x45, 0x0a, 0x3d, 0x7c, 0x08, 0x2a,
+	0x96, 0xa6, 0x22, 0xf9, 0x83, 0x39, 0xc8, 0x31, 0x17, 0x8b, 0x69, 0x74, 0x56, 0x60, 0x9e, 0xb7,
+	0x9e, 0x3d, 0x9e, 0xdd, 0xd3, 0xc3, 0x76, 0x50, 0xa2, 0x19, 0x8c, 0xa5, 0xa9, 0x1d, 0xe6, 0x90,
+	0xeb, 0xd9, 0xb2, 0xe1, 0x7d, 0x2d, 0x1b, 0xc7, 0x0e, 0xa4, 0x93, 0x21, 0x16, 0x40, 0x32, 0x6a,
+	0x06, 0xb8, 0x23, 0xcd, 0x62, 0x1a, 0x05, 0xc5, 0x86, 0x8e, 0x34, 0xdd, 0x22, 0xda, 0x7a, 0x9d,
+	0xad, 0x90, 0x3f, 0x5f, 0x21, 0xff, 0x7b, 0x85, 0xfc, 0x8f, 0x35, 0xf2, 0xe6, 0x6b, 0xe4, 0x7d,
+	0xae, 0x91, 0xf7, 0x72, 0xc7, 0x85, 0x19, 0x8c, 0xfb, 0x38, 0x86, 0x8c, 0x6c, 0xda, 0x4d, 0x01,
+	0x46, 0x42, 0xc6, 0x64, 0xdb, 0x74, 0xb4, 0xbd, 0xcc, 0x7f, 0x97, 0xea, 0x97, 0xf2, 0x42, 0x6f,
+	0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x74, 0x2c, 0xe3, 0xd0, 0x01, 0x00, 0x00,
+}
+
+func (m *CarryOver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CarryOver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CarryOver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCarryOver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintCarryOver(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintCarryOver(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCarryOver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCarryOver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CarryOver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovCarryOver(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovCarryOver(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCarryOver(uint64(l))
+	return n
+}
+
+func sovCarryOver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCarryOver(x uint64) (n int) {
+	return sovCarryOver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CarryOver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCarryOver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CarryOver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CarryOver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCarryOver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCarryOver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCarryOver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCarryOver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCarryOver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCarryOver(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCarryOver
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCarryOver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCarryOver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCarryOver
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCarryOver
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCarryOver
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCarryOver        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCarryOver          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCarryOver = fmt.Errorf("proto: unexpected end of group")
+)
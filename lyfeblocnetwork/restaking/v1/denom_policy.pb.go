@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/denom_policy.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NonBondDenomPolicy decides what happens to the portion of rewards an
+// auto-restake resolves in a denom other than the bond denom, which cannot be
+// delegated.
+type NonBondDenomPolicy int32
+
+const (
+	// NON_BOND_DENOM_POLICY_UNSPECIFIED is not a valid policy.
+	NonBondDenomPolicy_NON_BOND_DENOM_POLICY_UNSPECIFIED NonBondDenomPolicy = 0
+	// NON_BOND_DENOM_POLICY_LEAVE_LIQUID leaves the portion with the delegator.
+	NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID NonBondDenomPolicy = 1
+	// NON_BOND_DENOM_POLICY_COMMUNITY_POOL funds the community pool with the
+	// portion on the delegator's behalf.
+	NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL NonBondDenomPolicy = 2
+	// NON_BOND_DENOM_POLICY_CONVERT_ERC20 converts the portion to the ERC20
+	// token of its x/erc20 token pair, paid to the delegator's EVM address.
+	NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20 NonBondDenomPolicy = 3
+)
+
+var NonBondDenomPolicy_name = map[int32]string{
+	0: "NON_BOND_DENOM_POLICY_UNSPECIFIED",
+	1: "NON_BOND_DENOM_POLICY_LEAVE_LIQUID",
+	2: "NON_BOND_DENOM_POLICY_COMMUNITY_POOL",
+	3: "NON_BOND_DENOM_POLICY_CONVERT_ERC20",
+}
+
+var NonBondDenomPolicy_value = map[string]int32{
+	"NON_BOND_DENOM_POLICY_UNSPECIFIED":    0,
+	"NON_BOND_DENOM_POLICY_LEAVE_LIQUID":   1,
+	"NON_BOND_DENOM_POLICY_COMMUNITY_POOL": 2,
+	"NON_BOND_DENOM_POLICY_CONVERT_ERC20":  3,
+}
+
+func (x NonBondDenomPolicy) String() string {
+	return proto.EnumName(NonBondDenomPolicy_name, int32(x))
+}
+
+func (NonBondDenomPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a2bf7d8bb69d7ba, []int{0}
+}
+
+// DenomPolicy is the non-bond denom policy applied to a single denom in place
+// of the default one.
+type DenomPolicy struct {
+	Denom  string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Policy NonBondDenomPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=lyfeblocnetwork.restaking.v1.NonBondDenomPolicy" json:"policy,omitempty"`
+}
+
+func (m *DenomPolicy) Reset()         { *m = DenomPolicy{} }
+func (m *DenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomPolicy) ProtoMessage()    {}
+func (*DenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a2bf7d8bb69d7ba, []int{0}
+}
+func (m *DenomPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPolicy.Merge(m, src)
+}
+func (m *DenomPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPolicy proto.InternalMessageInfo
+
+func (m *DenomPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomPolicy) GetPolicy() NonBondDenomPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return NonBondDenomPolicy_NON_BOND_DENOM_POLICY_UNSPECIFIED
+}
+
+// NonBondDenomOutcome reports what an auto-restake did with the portion of
+// rewards it resolved in a denom other than the bond denom.
+type NonBondDenomOutcome struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// policy is the policy applied to amount. It is
+	// NON_BOND_DENOM_POLICY_LEAVE_LIQUID when the configured policy failed.
+	Policy NonBondDenomPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=lyfeblocnetwork.restaking.v1.NonBondDenomPolicy" json:"policy,omitempty"`
+	// error says why the configured policy failed, if it did.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *NonBondDenomOutcome) Reset()         { *m = NonBondDenomOutcome{} }
+func (m *NonBondDenomOutcome) String() string { return proto.CompactTextString(m) }
+func (*NonBondDenomOutcome) ProtoMessage()    {}
+func (*NonBondDenomOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a2bf7d8bb69d7ba, []int{1}
+}
+func (m *NonBondDenomOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonBondDenomOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonBondDenomOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonBondDenomOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonBondDenomOutcome.Merge(m, src)
+}
+func (m *NonBondDenomOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonBondDenomOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonBondDenomOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonBondDenomOutcome proto.InternalMessageInfo
+
+func (m *NonBondDenomOutcome) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *NonBondDenomOutcome) GetPolicy() NonBondDenomPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return NonBondDenomPolicy_NON_BOND_DENOM_POLICY_UNSPECIFIED
+}
+
+func (m *NonBondDenomOutcome) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("lyfeblocnetwork.restaking.v1.NonBondDenomPolicy", NonBondDenomPolicy_name, NonBondDenomPolicy_value)
+	proto.RegisterType((*DenomPolicy)(nil), "lyfeblocnetwork.restaking.v1.DenomPolicy")
+	proto.RegisterType((*NonBondDenomOutcome)(nil), "lyfeblocnetwork.restaking.v1.NonBondDenomOutcome")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/denom_policy.proto", fileDescriptor_9a2bf7d8bb69d7ba)
+}
+
+var fileDescriptor_9a2bf7d8bb69d7ba = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcf, 0xa9, 0x4c, 0x4b,
+	0x4d, 0xca, 0xc9, 0x4f, 0xce, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2f, 0x4a, 0x2d, 0x2e,
+	0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x8d, 0x2f,
+	0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x41, 0xd3, 0xa0,
+	0x07, 0xd7, 0xa0, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21,
+	0x1a, 0xa4, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb,
+	0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0xa0, 0xf2, 0x22, 0xe9, 0xf9,
+	0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x2a, 0xe6, 0xe2, 0x76, 0x01, 0x59, 0x1e,
+	0x00, 0xb6, 0x5b, 0x48, 0x84, 0x8b, 0x15, 0xec, 0x16, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20,
+	0x08, 0x47, 0xc8, 0x83, 0x8b, 0x0d, 0xe2, 0x36, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x3e, 0x23, 0x03,
+	0x3d, 0x7c, 0x8e, 0xd3, 0xf3, 0xcb, 0xcf, 0x73, 0xca, 0xcf, 0x4b, 0x41, 0x32, 0x37, 0x08, 0xaa,
+	0xdf, 0x8a, 0xe5, 0xc5, 0x02, 0x79, 0x46, 0xa5, 0xcd, 0x8c, 0x5c, 0xc2, 0xc8, 0x8a, 0xfc, 0x4b,
+	0x4b, 0x92, 0xf3, 0x73, 0x53, 0x85, 0x6c, 0xb8, 0xd8, 0x12, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0xc0,
+	0xd6, 0x73, 0x1b, 0x49, 0xea, 0x41, 0xfc, 0xa4, 0x07, 0xf2, 0x93, 0x1e, 0xd4, 0x4f, 0x7a, 0xce,
+	0xf9, 0x99, 0x79, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08,
+	0xaa, 0x87, 0x7a, 0xae, 0x04, 0x85, 0x42, 0x6a, 0x51, 0x51, 0x7e, 0x91, 0x04, 0x33, 0x24, 0x14,
+	0xc0, 0x1c, 0xad, 0x6d, 0x8c, 0x5c, 0x42, 0x98, 0x9a, 0x84, 0x54, 0xb9, 0x14, 0xfd, 0xfc, 0xfd,
+	0xe2, 0x9d, 0xfc, 0xfd, 0x5c, 0xe2, 0x5d, 0x5c, 0xfd, 0xfc, 0x7d, 0xe3, 0x03, 0xfc, 0x7d, 0x3c,
+	0x9d, 0x23, 0xe3, 0x43, 0xfd, 0x82, 0x03, 0x5c, 0x9d, 0x3d, 0xdd, 0x3c, 0x5d, 0x5d, 0x04, 0x18,
+	0x84, 0xd4, 0xb8, 0x94, 0xb0, 0x2b, 0xf3, 0x71, 0x75, 0x0c, 0x73, 0x8d, 0xf7, 0xf1, 0x0c, 0x0c,
+	0xf5, 0x74, 0x11, 0x60, 0x14, 0xd2, 0xe0, 0x52, 0xc1, 0xae, 0xce, 0xd9, 0xdf, 0xd7, 0x37, 0xd4,
+	0xcf, 0x33, 0x24, 0x32, 0x3e, 0xc0, 0xdf, 0xdf, 0x47, 0x80, 0x49, 0x48, 0x9d, 0x4b, 0x19, 0x97,
+	0x4a, 0xbf, 0x30, 0xd7, 0xa0, 0x90, 0x78, 0xd7, 0x20, 0x67, 0x23, 0x03, 0x01, 0x66, 0xa7, 0xb8,
+	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
+	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x72, 0x49, 0xcf, 0x2c, 0xc9, 0x28,
+	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0x05, 0x27, 0xd0, 0x9c, 0xfc, 0xfc, 0x82, 0xcc, 0xbc, 0x64, 0x78,
+	0x62, 0xd5, 0x85, 0xa5, 0x56, 0x7c, 0xa9, 0x37, 0x89, 0x0d, 0x9c, 0x94, 0x8c, 0x01, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x50, 0xe4, 0x5e, 0x1b, 0xe4, 0x02, 0x00, 0x00,
+}
+
+func (this *DenomPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPolicy)
+	if !ok {
+		that2, ok := that.(DenomPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Policy != that1.Policy {
+		return false
+	}
+	return true
+}
+func (m *DenomPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintDenomPolicy(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDenomPolicy(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonBondDenomOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonBondDenomOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonBondDenomOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDenomPolicy(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Policy != 0 {
+		i = encodeVarintDenomPolicy(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDenomPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDenomPolicy(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovDenomPolicy(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *NonBondDenomOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovDenomPolicy(uint64(l))
+	if m.Policy != 0 {
+		n += 1 + sovDenomPolicy(uint64(m.Policy))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDenomPolicy(uint64(l))
+	}
+	return n
+}
+
+func sovDenomPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomPolicy(x uint64) (n int) {
+	return sovDenomPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= NonBondDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonBondDenomOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonBondDenomOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonBondDenomOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= NonBondDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomPolicy = fmt.Errorf("proto: unexpected end of group")
+)
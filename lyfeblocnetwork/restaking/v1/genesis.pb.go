@@ -0,0 +1,955 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/genesis.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the restaking module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// validator_overrides are the per-validator auto-restake ratios.
+	ValidatorOverrides []ValidatorOverride `protobuf:"bytes,2,rep,name=validator_overrides,json=validatorOverrides,proto3" json:"validator_overrides"`
+	// delegator_preferences are the per-delegator auto-restake preferences.
+	DelegatorPreferences []DelegatorPreference `protobuf:"bytes,3,rep,name=delegator_preferences,json=delegatorPreferences,proto3" json:"delegator_preferences"`
+	// restake_history holds each delegator's recent auto-restakes, oldest first.
+	RestakeHistory []RestakeRecord `protobuf:"bytes,4,rep,name=restake_history,json=restakeHistory,proto3" json:"restake_history"`
+	// delegator_stats are the cumulative auto-restaked amounts per delegator.
+	// The chain-wide total and the active restaker count are derived from them.
+	DelegatorStats []DelegatorRestakeStats `protobuf:"bytes,5,rep,name=delegator_stats,json=delegatorStats,proto3" json:"delegator_stats"`
+	// validator_stats are the cumulative auto-restaked amounts per validator.
+	ValidatorStats []ValidatorRestakeStats `protobuf:"bytes,6,rep,name=validator_stats,json=validatorStats,proto3" json:"validator_stats"`
+	// retry_entries are the queued auto-restake retries.
+	RetryEntries []RetryEntry `protobuf:"bytes,7,rep,name=retry_entries,json=retryEntries,proto3" json:"retry_entries"`
+	// next_retry_id is the id assigned to the next queued retry.
+	NextRetryId uint64 `protobuf:"varint,8,opt,name=next_retry_id,json=nextRetryId,proto3" json:"next_retry_id,omitempty"`
+	// deferred_withdrawals are the withdrawals awaiting auto-restake, in
+	// processing order.
+	DeferredWithdrawals []RewardWithdrawal `protobuf:"bytes,9,rep,name=deferred_withdrawals,json=deferredWithdrawals,proto3" json:"deferred_withdrawals"`
+	// carry_overs are the amounts held back from auto-restakes below
+	// min_restake_amount.
+	CarryOvers []CarryOver `protobuf:"bytes,10,rep,name=carry_overs,json=carryOvers,proto3" json:"carry_overs"`
+	// restaked_shares are the auto-restaked delegation shares still held.
+	RestakedShares []RestakedShares `protobuf:"bytes,11,rep,name=restaked_shares,json=restakedShares,proto3" json:"restaked_shares"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb06988520e32f31, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetValidatorOverrides() []ValidatorOverride {
+	if m != nil {
+		return m.ValidatorOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorPreferences() []DelegatorPreference {
+	if m != nil {
+		return m.DelegatorPreferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRestakeHistory() []RestakeRecord {
+	if m != nil {
+		return m.RestakeHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorStats() []DelegatorRestakeStats {
+	if m != nil {
+		return m.DelegatorStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorStats() []ValidatorRestakeStats {
+	if m != nil {
+		return m.ValidatorStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetryEntries() []RetryEntry {
+	if m != nil {
+		return m.RetryEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRetryId() uint64 {
+	if m != nil {
+		return m.NextRetryId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDeferredWithdrawals() []RewardWithdrawal {
+	if m != nil {
+		return m.DeferredWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCarryOvers() []CarryOver {
+	if m != nil {
+		return m.CarryOvers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRestakedShares() []RestakedShares {
+	if m != nil {
+		return m.RestakedShares
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "lyfeblocnetwork.restaking.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/genesis.proto", fileDescriptor_bb06988520e32f31)
+}
+
+var fileDescriptor_bb06988520e32f31 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x1a, 0x52, 0xba, 0x69, 0xa9, 0x70, 0x83, 0x64, 0x55, 0xc8, 0x44, 0x15, 0x12,
+	0xa1, 0x50, 0x5b, 0x69, 0xdf, 0xa0, 0x14, 0x15, 0x2e, 0x50, 0x25, 0x12, 0x48, 0x45, 0xc2, 0xda,
+	0x78, 0x27, 0xce, 0xaa, 0x8e, 0x37, 0x9a, 0x5d, 0x12, 0xf2, 0x16, 0x3c, 0x06, 0x47, 0x1e, 0xa3,
+	0xc7, 0x1e, 0x39, 0xa1, 0x2a, 0x39, 0xf0, 0x1a, 0xc8, 0xeb, 0xb5, 0x2d, 0x05, 0xe4, 0xe6, 0x12,
+	0x59, 0xb3, 0xf3, 0xff, 0xdf, 0xec, 0xce, 0x64, 0xc8, 0x61, 0x3c, 0x1f, 0xc2, 0x20, 0x16, 0x61,
+	0x02, 0x6a, 0x26, 0xf0, 0xca, 0x47, 0x90, 0x8a, 0x5e, 0xf1, 0x24, 0xf2, 0xa7, 0x5d, 0x3f, 0x82,
+	0x04, 0x24, 0x97, 0xde, 0x04, 0x85, 0x12, 0xf6, 0x93, 0x95, 0x5c, 0xaf, 0xc8, 0xf5, 0xa6, 0xdd,
+	0xfd, 0x47, 0x74, 0xcc, 0x13, 0xe1, 0xeb, 0xdf, 0x4c, 0xb0, 0xdf, 0x8a, 0x44, 0x24, 0xf4, 0xa7,
+	0x9f, 0x7e, 0x99, 0xe8, 0x51, 0x25, 0x32, 0xa4, 0x88, 0xf3, 0x40, 0x4c, 0x01, 0x4d, 0x7a, 0x75,
+	0x85, 0x23, 0x2e, 0x95, 0xc0, 0xb9, 0xc9, 0x7d, 0x51, 0x99, 0x3b, 0xa1, 0x48, 0xc7, 0x72, 0xad,
+	0x2a, 0x26, 0x08, 0x43, 0x40, 0x48, 0x42, 0x30, 0xe9, 0x9d, 0xca, 0x74, 0x04, 0x55, 0xd4, 0x50,
+	0x6d, 0x3c, 0xe3, 0x6a, 0xc4, 0x90, 0xce, 0x68, 0xbc, 0x96, 0xb1, 0x54, 0x54, 0x99, 0x8a, 0x0f,
+	0x6e, 0x37, 0xc9, 0xf6, 0x79, 0xd6, 0x90, 0xbe, 0xa2, 0x0a, 0xec, 0x73, 0xd2, 0xc8, 0xae, 0xe4,
+	0x58, 0x6d, 0xab, 0xd3, 0x3c, 0x7e, 0xe6, 0x55, 0x35, 0xc8, 0xbb, 0xd0, 0xb9, 0xa7, 0x5b, 0xd7,
+	0xbf, 0x9f, 0xd6, 0x7e, 0xfc, 0xf9, 0x79, 0x68, 0xf5, 0x8c, 0xdc, 0x1e, 0x92, 0xbd, 0x29, 0x8d,
+	0x39, 0xa3, 0x4a, 0xa0, 0x7e, 0x7a, 0xe4, 0x0c, 0xa4, 0x73, 0xaf, 0xbd, 0xd1, 0x69, 0x1e, 0xfb,
+	0xd5, 0xae, 0x1f, 0x73, 0xe1, 0x07, 0xa3, 0x3b, 0xad, 0xa7, 0x80, 0x9e, 0x3d, 0x5d, 0x3d, 0x90,
+	0x76, 0x4c, 0x1e, 0x33, 0x88, 0x21, 0xd2, 0x9c, 0xf2, 0x89, 0xa5, 0xb3, 0xa1, 0x49, 0xdd, 0x6a,
+	0xd2, 0x59, 0x2e, 0xbd, 0x28, 0x94, 0x86, 0xd5, 0x62, 0xff, 0x1e, 0x49, 0xfb, 0x92, 0xec, 0x66,
+	0x7a, 0x08, 0xcc, 0x94, 0x38, 0x75, 0xcd, 0x79, 0x59, 0xcd, 0xe9, 0x65, 0xa2, 0x1e, 0x84, 0x02,
+	0x99, 0x21, 0x3c, 0x34, 0x4e, 0x6f, 0x33, 0x23, 0x7b, 0x40, 0x76, 0xcb, 0x9b, 0xe8, 0x26, 0x39,
+	0xf7, 0xb5, 0xf7, 0xc9, 0x9a, 0x77, 0x30, 0x90, 0xb4, 0x91, 0x32, 0x67, 0x14, 0x8e, 0x3a, 0x9a,
+	0x32, 0xca, 0xae, 0x64, 0x8c, 0xc6, 0x3a, 0x8c, 0xa2, 0x23, 0xff, 0x63, 0x14, 0x8e, 0x19, 0xa3,
+	0x4f, 0x76, 0xf4, 0xec, 0x06, 0x90, 0x28, 0xe4, 0x20, 0x9d, 0x4d, 0x4d, 0xe8, 0xdc, 0xf5, 0x42,
+	0x0a, 0xe7, 0x6f, 0x12, 0x85, 0x73, 0x63, 0xbb, 0x8d, 0x79, 0x84, 0x83, 0xb4, 0x0f, 0xc8, 0x4e,
+	0x02, 0xdf, 0x54, 0x90, 0x39, 0x73, 0xe6, 0x3c, 0x68, 0x5b, 0x9d, 0x7a, 0xaf, 0x99, 0x06, 0xb5,
+	0xf4, 0x1d, 0xb3, 0x23, 0xd2, 0x62, 0x69, 0xab, 0x10, 0x58, 0x50, 0xfe, 0x27, 0xa4, 0xb3, 0xa5,
+	0xf9, 0xde, 0x5d, 0xfc, 0x19, 0x45, 0xf6, 0xa9, 0x90, 0x99, 0x2a, 0xf6, 0x72, 0xc7, 0xf2, 0x44,
+	0xda, 0xef, 0x49, 0xb3, 0x5c, 0x29, 0xd2, 0x21, 0xda, 0xff, 0x79, 0xb5, 0xff, 0xeb, 0x54, 0x90,
+	0x8e, 0xad, 0x31, 0x26, 0x61, 0x1e, 0x90, 0xf6, 0xe7, 0x62, 0xaa, 0x58, 0x20, 0x47, 0x14, 0x41,
+	0x3a, 0x4d, 0xed, 0xf9, 0x6a, 0xad, 0xa9, 0x62, 0x7d, 0xad, 0x59, 0x19, 0xab, 0x3c, 0xfa, 0xe5,
+	0x7a, 0xe1, 0x5a, 0x37, 0x0b, 0xd7, 0xba, 0x5d, 0xb8, 0xd6, 0xf7, 0xa5, 0x5b, 0xbb, 0x59, 0xba,
+	0xb5, 0x5f, 0x4b, 0xb7, 0x76, 0x79, 0x16, 0x71, 0x35, 0xfa, 0x3a, 0xf0, 0x42, 0x31, 0xf6, 0x53,
+	0x4e, 0x2c, 0xc4, 0x84, 0x27, 0xa1, 0x9f, 0x33, 0x8f, 0xf2, 0xf5, 0x51, 0xb5, 0x4e, 0x06, 0x0d,
+	0xbd, 0x49, 0x4e, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x53, 0x36, 0x11, 0xf6, 0x05, 0x00,
+	0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RestakedShares) > 0 {
+		for iNdEx := len(m.RestakedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CarryOvers) > 0 {
+		for iNdEx := len(m.CarryOvers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CarryOvers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DeferredWithdrawals) > 0 {
+		for iNdEx := len(m.DeferredWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeferredWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextRetryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRetryId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RetryEntries) > 0 {
+		for iNdEx := len(m.RetryEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorStats) > 0 {
+		for iNdEx := len(m.ValidatorStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegatorStats) > 0 {
+		for iNdEx := len(m.DelegatorStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RestakeHistory) > 0 {
+		for iNdEx := len(m.RestakeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatorPreferences) > 0 {
+		for iNdEx := len(m.DelegatorPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorOverrides) > 0 {
+		for iNdEx := len(m.ValidatorOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorOverrides) > 0 {
+		for _, e := range m.ValidatorOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorPreferences) > 0 {
+		for _, e := range m.DelegatorPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RestakeHistory) > 0 {
+		for _, e := range m.RestakeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorStats) > 0 {
+		for _, e := range m.DelegatorStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorStats) > 0 {
+		for _, e := range m.ValidatorStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetryEntries) > 0 {
+		for _, e := range m.RetryEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRetryId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRetryId))
+	}
+	if len(m.DeferredWithdrawals) > 0 {
+		for _, e := range m.DeferredWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CarryOvers) > 0 {
+		for _, e := range m.CarryOvers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RestakedShares) > 0 {
+		for _, e := range m.RestakedShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOverrides = append(m.ValidatorOverrides, ValidatorOverride{})
+			if err := m.ValidatorOverrides[len(m.ValidatorOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorPreferences = append(m.DelegatorPreferences, DelegatorPreference{})
+			if err := m.DelegatorPreferences[len(m.DelegatorPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakeHistory = append(m.RestakeHistory, RestakeRecord{})
+			if err := m.RestakeHistory[len(m.RestakeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorStats = append(m.DelegatorStats, DelegatorRestakeStats{})
+			if err := m.DelegatorStats[len(m.DelegatorStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorStats = append(m.ValidatorStats, ValidatorRestakeStats{})
+			if err := m.ValidatorStats[len(m.ValidatorStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryEntries = append(m.RetryEntries, RetryEntry{})
+			if err := m.RetryEntries[len(m.RetryEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryId", wireType)
+			}
+			m.NextRetryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeferredWithdrawals = append(m.DeferredWithdrawals, RewardWithdrawal{})
+			if err := m.DeferredWithdrawals[len(m.DeferredWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryOvers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CarryOvers = append(m.CarryOvers, CarryOver{})
+			if err := m.CarryOvers[len(m.CarryOvers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakedShares = append(m.RestakedShares, RestakedShares{})
+			if err := m.RestakedShares[len(m.RestakedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,731 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/history.proto
+
+package v1
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RatioSource identifies where the ratio applied to an auto-restake came from.
+type RatioSource int32
+
+const (
+	// RATIO_SOURCE_UNSPECIFIED is never assigned to an executed restake.
+	RatioSource_RATIO_SOURCE_UNSPECIFIED RatioSource = 0
+	// RATIO_SOURCE_PARAMS is the global auto_restake_ratio parameter.
+	RatioSource_RATIO_SOURCE_PARAMS RatioSource = 1
+	// RATIO_SOURCE_VALIDATOR_OVERRIDE is the paying validator's override.
+	RatioSource_RATIO_SOURCE_VALIDATOR_OVERRIDE RatioSource = 2
+	// RATIO_SOURCE_DELEGATOR_PREFERENCE is the delegator-wide preference.
+	RatioSource_RATIO_SOURCE_DELEGATOR_PREFERENCE RatioSource = 3
+	// RATIO_SOURCE_DELEGATOR_VALIDATOR_PREFERENCE is the delegator's entry for
+	// the paying validator.
+	RatioSource_RATIO_SOURCE_DELEGATOR_VALIDATOR_PREFERENCE RatioSource = 4
+)
+
+var RatioSource_name = map[int32]string{
+	0: "RATIO_SOURCE_UNSPECIFIED",
+	1: "RATIO_SOURCE_PARAMS",
+	2: "RATIO_SOURCE_VALIDATOR_OVERRIDE",
+	3: "RATIO_SOURCE_DELEGATOR_PREFERENCE",
+	4: "RATIO_SOURCE_DELEGATOR_VALIDATOR_PREFERENCE",
+}
+
+var RatioSource_value = map[string]int32{
+	"RATIO_SOURCE_UNSPECIFIED":                    0,
+	"RATIO_SOURCE_PARAMS":                         1,
+	"RATIO_SOURCE_VALIDATOR_OVERRIDE":             2,
+	"RATIO_SOURCE_DELEGATOR_PREFERENCE":           3,
+	"RATIO_SOURCE_DELEGATOR_VALIDATOR_PREFERENCE": 4,
+}
+
+func (x RatioSource) String() string {
+	return proto.EnumName(RatioSource_name, int32(x))
+}
+
+func (RatioSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fd4f26c2057fcbf1, []int{0}
+}
+
+// RestakeRecord is an executed auto-restake kept in the delegator's history.
+type RestakeRecord struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the rewards delegated back to the validator.
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Ratio       cosmossdk_io_math.LegacyDec              `protobuf:"bytes,4,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+	RatioSource RatioSource                              `protobuf:"varint,5,opt,name=ratio_source,json=ratioSource,proto3,enum=lyfeblocnetwork.restaking.v1.RatioSource" json:"ratio_source,omitempty"`
+	Height      int64                                    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// redirected_from is the unhealthy validator that paid the rewards when
+	// they were redirected to validator_address.
+	RedirectedFrom string `protobuf:"bytes,7,opt,name=redirected_from,json=redirectedFrom,proto3" json:"redirected_from,omitempty"`
+	// diversified_from is the validator that paid the rewards when they were
+	// split across the delegator's diversification targets.
+	DiversifiedFrom string `protobuf:"bytes,8,opt,name=diversified_from,json=diversifiedFrom,proto3" json:"diversified_from,omitempty"`
+}
+
+func (m *RestakeRecord) Reset()         { *m = RestakeRecord{} }
+func (m *RestakeRecord) String() string { return proto.CompactTextString(m) }
+func (*RestakeRecord) ProtoMessage()    {}
+func (*RestakeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd4f26c2057fcbf1, []int{0}
+}
+func (m *RestakeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakeRecord.Merge(m, src)
+}
+func (m *RestakeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakeRecord proto.InternalMessageInfo
+
+func (m *RestakeRecord) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *RestakeRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RestakeRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RestakeRecord) GetRatioSource() RatioSource {
+	if m != nil {
+		return m.RatioSource
+	}
+	return RatioSource_RATIO_SOURCE_UNSPECIFIED
+}
+
+func (m *RestakeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RestakeRecord) GetRedirectedFrom() string {
+	if m != nil {
+		return m.RedirectedFrom
+	}
+	return ""
+}
+
+func (m *RestakeRecord) GetDiversifiedFrom() string {
+	if m != nil {
+		return m.DiversifiedFrom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("lyfeblocnetwork.restaking.v1.RatioSource", RatioSource_name, RatioSource_value)
+	proto.RegisterType((*RestakeRecord)(nil), "lyfeblocnetwork.restaking.v1.RestakeRecord")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/history.proto", fileDescriptor_fd4f26c2057fcbf1)
+}
+
+var fileDescriptor_fd4f26c2057fcbf1 = []byte{
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x4f, 0xdb, 0x3c,
+	0x18, 0xc7, 0x1b, 0x0a, 0x7d, 0xdf, 0x99, 0x0d, 0x8a, 0x87, 0xb6, 0xc0, 0x58, 0x5a, 0x36, 0x4d,
+	0xea, 0x98, 0x1a, 0xab, 0x4c, 0xfb, 0x00, 0xa5, 0x31, 0xa8, 0x53, 0x47, 0x91, 0x0b, 0x1c, 0x76,
+	0x58, 0x94, 0x26, 0x26, 0xb5, 0xda, 0xc4, 0xc8, 0x36, 0x9d, 0xfa, 0x2d, 0xf6, 0x31, 0xa6, 0x9d,
+	0x76, 0xe0, 0x0b, 0xec, 0xc6, 0x11, 0x71, 0xda, 0x76, 0x60, 0x13, 0x1c, 0xf6, 0x35, 0xa6, 0x26,
+	0x2e, 0xb4, 0x48, 0x43, 0x5c, 0x12, 0x3f, 0xcf, 0xf3, 0xff, 0xff, 0xe2, 0x3c, 0x4f, 0x1c, 0xb0,
+	0xd6, 0x1b, 0x1c, 0xd0, 0x76, 0x8f, 0xfb, 0x31, 0x55, 0x1f, 0xb9, 0xe8, 0x22, 0x41, 0xa5, 0xf2,
+	0xba, 0x2c, 0x0e, 0x51, 0xbf, 0x82, 0x3a, 0x4c, 0x2a, 0x2e, 0x06, 0xf6, 0xa1, 0xe0, 0x8a, 0xc3,
+	0x95, 0x1b, 0x5a, 0xfb, 0x4a, 0x6b, 0xf7, 0x2b, 0xcb, 0x0b, 0x5e, 0xc4, 0x62, 0x8e, 0x92, 0x6b,
+	0x6a, 0x58, 0xb6, 0x7c, 0x2e, 0x23, 0x2e, 0x51, 0xdb, 0x93, 0x14, 0xf5, 0x2b, 0x6d, 0xaa, 0xbc,
+	0x0a, 0xf2, 0x39, 0x8b, 0x75, 0x7d, 0x29, 0xad, 0xbb, 0x49, 0x84, 0xd2, 0x40, 0x97, 0x16, 0x43,
+	0x1e, 0xf2, 0x34, 0x3f, 0x5c, 0xa5, 0xd9, 0x67, 0x3f, 0xa6, 0xc1, 0x03, 0x92, 0x3c, 0x94, 0x12,
+	0xea, 0x73, 0x11, 0x40, 0x0c, 0x16, 0x02, 0xda, 0xa3, 0xa1, 0xa7, 0xb8, 0x70, 0xbd, 0x20, 0x10,
+	0x54, 0x4a, 0xd3, 0x28, 0x1a, 0xa5, 0x7b, 0x1b, 0xe6, 0xd9, 0x71, 0x79, 0x51, 0x43, 0xab, 0x69,
+	0xa5, 0xa5, 0x04, 0x8b, 0x43, 0x92, 0xbf, 0xb2, 0xe8, 0x3c, 0xdc, 0x06, 0x0b, 0x7d, 0xaf, 0xc7,
+	0x82, 0x09, 0xcc, 0x54, 0x82, 0x59, 0x3d, 0x3b, 0x2e, 0x3f, 0xd5, 0x98, 0xfd, 0x91, 0xe6, 0x06,
+	0xaf, 0x7f, 0x23, 0x0f, 0x3b, 0x20, 0xe7, 0x45, 0xfc, 0x28, 0x56, 0x66, 0xb6, 0x98, 0x2d, 0xcd,
+	0xae, 0x2f, 0xd9, 0x9a, 0x30, 0x6c, 0x85, 0xad, 0x5b, 0x61, 0xd7, 0x38, 0x8b, 0x37, 0xde, 0x9c,
+	0x9c, 0x17, 0x32, 0x5f, 0x7e, 0x15, 0x4a, 0x21, 0x53, 0x9d, 0xa3, 0xb6, 0xed, 0xf3, 0x48, 0xb7,
+	0x42, 0xdf, 0xca, 0x32, 0xe8, 0x22, 0x35, 0x38, 0xa4, 0x32, 0x31, 0xc8, 0xcf, 0x7f, 0xbe, 0xae,
+	0x19, 0x44, 0xf3, 0xe1, 0x16, 0x98, 0x11, 0x9e, 0x62, 0xdc, 0x9c, 0x4e, 0x76, 0x5b, 0x19, 0xd2,
+	0x7e, 0x9e, 0x17, 0x9e, 0xa4, 0x5e, 0x19, 0x74, 0x6d, 0xc6, 0x51, 0xe4, 0xa9, 0x8e, 0xdd, 0xa0,
+	0xa1, 0xe7, 0x0f, 0x1c, 0xea, 0x9f, 0x1d, 0x97, 0x81, 0xde, 0x8e, 0x43, 0x7d, 0x92, 0xfa, 0x61,
+	0x03, 0xdc, 0x4f, 0x16, 0xae, 0xe4, 0x47, 0xc2, 0xa7, 0xe6, 0x4c, 0xd1, 0x28, 0xcd, 0xad, 0xbf,
+	0xb4, 0x6f, 0x1b, 0xba, 0x4d, 0x86, 0x8e, 0x56, 0x62, 0x20, 0xb3, 0xe2, 0x3a, 0x80, 0x8f, 0x40,
+	0xae, 0x43, 0x59, 0xd8, 0x51, 0x66, 0xae, 0x68, 0x94, 0xb2, 0x44, 0x47, 0xf0, 0x2d, 0x98, 0x17,
+	0x34, 0x60, 0x82, 0xfa, 0x8a, 0x06, 0xee, 0x81, 0xe0, 0x91, 0xf9, 0xdf, 0x5d, 0xdb, 0x3c, 0x77,
+	0xed, 0xdc, 0x14, 0x3c, 0x82, 0x0d, 0x90, 0x0f, 0x58, 0x9f, 0x0a, 0xc9, 0x0e, 0xd8, 0x08, 0xf6,
+	0xff, 0x5d, 0x61, 0xf3, 0x63, 0xd6, 0x21, 0x6d, 0xed, 0x9b, 0x01, 0x66, 0xc7, 0x5e, 0x07, 0xae,
+	0x00, 0x93, 0x54, 0x77, 0xeb, 0x4d, 0xb7, 0xd5, 0xdc, 0x23, 0x35, 0xec, 0xee, 0x6d, 0xb7, 0x76,
+	0x70, 0xad, 0xbe, 0x59, 0xc7, 0x4e, 0x3e, 0x03, 0x1f, 0x83, 0x87, 0x13, 0xd5, 0x9d, 0x2a, 0xa9,
+	0xbe, 0x6b, 0xe5, 0x0d, 0xf8, 0x1c, 0x14, 0x26, 0x0a, 0xfb, 0xd5, 0x46, 0xdd, 0xa9, 0xee, 0x36,
+	0x89, 0xdb, 0xdc, 0xc7, 0x84, 0xd4, 0x1d, 0x9c, 0x9f, 0x82, 0x2f, 0xc0, 0xea, 0x84, 0xc8, 0xc1,
+	0x0d, 0xbc, 0x95, 0x88, 0x76, 0x08, 0xde, 0xc4, 0x04, 0x6f, 0xd7, 0x70, 0x3e, 0x0b, 0x11, 0x78,
+	0xf5, 0x0f, 0xd9, 0x35, 0x75, 0xcc, 0x30, 0xbd, 0xf1, 0xe1, 0xe4, 0xc2, 0x32, 0x4e, 0x2f, 0x2c,
+	0xe3, 0xf7, 0x85, 0x65, 0x7c, 0xba, 0xb4, 0x32, 0xa7, 0x97, 0x56, 0xe6, 0xfb, 0xa5, 0x95, 0x79,
+	0xef, 0x8c, 0x7d, 0x5d, 0xc3, 0x89, 0xf6, 0x38, 0x3f, 0x64, 0xb1, 0x8f, 0x46, 0xd3, 0x2d, 0x8f,
+	0xce, 0xff, 0x6d, 0xff, 0x83, 0x76, 0x2e, 0x39, 0x86, 0xaf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
+	0x86, 0x18, 0xb5, 0xca, 0x36, 0x04, 0x00, 0x00,
+}
+
+func (m *RestakeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestakeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestakeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiversifiedFrom) > 0 {
+		i -= len(m.DiversifiedFrom)
+		copy(dAtA[i:], m.DiversifiedFrom)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.DiversifiedFrom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RedirectedFrom) > 0 {
+		i -= len(m.RedirectedFrom)
+		copy(dAtA[i:], m.RedirectedFrom)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RedirectedFrom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RatioSource != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.RatioSource))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RestakeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.RatioSource != 0 {
+		n += 1 + sovHistory(uint64(m.RatioSource))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = len(m.RedirectedFrom)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.DiversifiedFrom)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RestakeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestakeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestakeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioSource", wireType)
+			}
+			m.RatioSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatioSource |= RatioSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiversifiedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiversifiedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1325 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/params.proto
+
+package v1
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnhealthyValidatorPolicy decides what happens to rewards that would be
+// restaked to a jailed, unbonding or underperforming validator.
+type UnhealthyValidatorPolicy int32
+
+const (
+	// UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED is not a valid policy.
+	UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED UnhealthyValidatorPolicy = 0
+	// UNHEALTHY_VALIDATOR_POLICY_SKIP leaves the rewards where they are. In
+	// epoch mode they keep accruing in the distribution module; otherwise they
+	// stay with the delegator.
+	UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SKIP UnhealthyValidatorPolicy = 1
+	// UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR pays the rewards out to the
+	// delegator without restaking them.
+	UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR UnhealthyValidatorPolicy = 2
+	// UNHEALTHY_VALIDATOR_POLICY_REDIRECT restakes to the delegator's fallback
+	// validator when one is set and healthy, and otherwise pays the rewards out
+	// to the delegator.
+	UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_REDIRECT UnhealthyValidatorPolicy = 3
+)
+
+var UnhealthyValidatorPolicy_name = map[int32]string{
+	0: "UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED",
+	1: "UNHEALTHY_VALIDATOR_POLICY_SKIP",
+	2: "UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR",
+	3: "UNHEALTHY_VALIDATOR_POLICY_REDIRECT",
+}
+
+var UnhealthyValidatorPolicy_value = map[string]int32{
+	"UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED":       0,
+	"UNHEALTHY_VALIDATOR_POLICY_SKIP":              1,
+	"UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR": 2,
+	"UNHEALTHY_VALIDATOR_POLICY_REDIRECT":          3,
+}
+
+func (x UnhealthyValidatorPolicy) String() string {
+	return proto.EnumName(UnhealthyValidatorPolicy_name, int32(x))
+}
+
+func (UnhealthyValidatorPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_225814d2d9c7e018, []int{0}
+}
+
+// Params defines the parameters for the restaking module.
+type Params struct {
+	// auto_restake_ratio is the share of withdrawn rewards that is delegated
+	// back to the validator that paid them.
+	AutoRestakeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=auto_restake_ratio,json=autoRestakeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"auto_restake_ratio"`
+	// min_validator_ratio is the lowest ratio a validator may set as its
+	// per-validator override.
+	MinValidatorRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_validator_ratio,json=minValidatorRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_validator_ratio"`
+	// max_validator_ratio is the highest ratio a validator may set as its
+	// per-validator override.
+	MaxValidatorRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_validator_ratio,json=maxValidatorRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_validator_ratio"`
+	// epoch_identifier switches the module to epoch mode when set. The rewards
+	// of every delegator with a stored preference are then compounded after the
+	// end of each epoch with this identifier, within the per-block caps, and
+	// withdrawals are no longer restaked. An empty identifier restakes rewards
+	// as they are withdrawn.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// max_retry_attempts is the number of times a failed auto-restake is
+	// retried before it is marked failed. Zero disables retries.
+	MaxRetryAttempts uint32 `protobuf:"varint,5,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	// retry_backoff_blocks is the delay before the first retry. Each further
+	// retry waits twice as long as the one before.
+	RetryBackoffBlocks uint64 `protobuf:"varint,6,opt,name=retry_backoff_blocks,json=retryBackoffBlocks,proto3" json:"retry_backoff_blocks,omitempty"`
+	// max_restakes_per_block caps the auto-restakes, epoch compoundings and
+	// retries executed in one block. Withdrawals and delegations to compound
+	// over the cap are deferred to the next block in order.
+	MaxRestakesPerBlock uint32 `protobuf:"varint,7,opt,name=max_restakes_per_block,json=maxRestakesPerBlock,proto3" json:"max_restakes_per_block,omitempty"`
+	// max_restake_gas_per_block caps the gas the auto-restakes of one block may
+	// consume. It is checked between restakes, so the last restake of a block
+	// may go over it.
+	MaxRestakeGasPerBlock uint64 `protobuf:"varint,8,opt,name=max_restake_gas_per_block,json=maxRestakeGasPerBlock,proto3" json:"max_restake_gas_per_block,omitempty"`
+	// unhealthy_validator_policy applies when rewards would be restaked to a
+	// validator that is jailed, not bonded, charges more than
+	// max_validator_commission or signed fewer blocks than min_validator_uptime.
+	UnhealthyValidatorPolicy UnhealthyValidatorPolicy `protobuf:"varint,9,opt,name=unhealthy_validator_policy,json=unhealthyValidatorPolicy,proto3,enum=lyfeblocnetwork.restaking.v1.UnhealthyValidatorPolicy" json:"unhealthy_validator_policy,omitempty"`
+	// max_validator_commission is the highest commission rate a validator may
+	// charge and still be restaked to.
+	MaxValidatorCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_validator_commission,json=maxValidatorCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_validator_commission"`
+	// min_validator_uptime is the lowest share of the slashing signed blocks
+	// window a validator must have signed to be restaked to. Zero disables the
+	// check.
+	MinValidatorUptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=min_validator_uptime,json=minValidatorUptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_validator_uptime"`
+	// min_restake_amount is the smallest amount of bond denom delegated by an
+	// auto-restake. Smaller restakes are carried over until the delegation has
+	// accumulated the minimum. Zero disables the carry-over.
+	MinRestakeAmount cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_restake_amount,json=minRestakeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_restake_amount"`
+	// non_bond_denom_policy applies to the portion of rewards in denoms other
+	// than the bond denom, unless denom_policies has an entry for the denom.
+	NonBondDenomPolicy NonBondDenomPolicy `protobuf:"varint,13,opt,name=non_bond_denom_policy,json=nonBondDenomPolicy,proto3,enum=lyfeblocnetwork.restaking.v1.NonBondDenomPolicy" json:"non_bond_denom_policy,omitempty"`
+	// denom_policies overrides non_bond_denom_policy for single denoms.
+	DenomPolicies []DenomPolicy `protobuf:"bytes,14,rep,name=denom_policies,json=denomPolicies,proto3" json:"denom_policies"`
+	// failed_retry_retention_blocks is the number of blocks a retry entry that
+	// used up its attempts is kept for, so the delegator can still force-retry
+	// or cancel it, before it is pruned.
+	FailedRetryRetentionBlocks uint64 `protobuf:"varint,15,opt,name=failed_retry_retention_blocks,json=failedRetryRetentionBlocks,proto3" json:"failed_retry_retention_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_225814d2d9c7e018, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxRetryAttempts() uint32 {
+	if m != nil {
+		return m.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetRetryBackoffBlocks() uint64 {
+	if m != nil {
+		return m.RetryBackoffBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRestakesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxRestakesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRestakeGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRestakeGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetUnhealthyValidatorPolicy() UnhealthyValidatorPolicy {
+	if m != nil {
+		return m.UnhealthyValidatorPolicy
+	}
+	return UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED
+}
+
+func (m *Params) GetNonBondDenomPolicy() NonBondDenomPolicy {
+	if m != nil {
+		return m.NonBondDenomPolicy
+	}
+	return NonBondDenomPolicy_NON_BOND_DENOM_POLICY_UNSPECIFIED
+}
+
+func (m *Params) GetDenomPolicies() []DenomPolicy {
+	if m != nil {
+		return m.DenomPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetFailedRetryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.FailedRetryRetentionBlocks
+	}
+	return 0
+}
+
+// ValidatorOverride is a validator-specific auto-restake ratio that takes
+// precedence over the global ratio for rewards paid by that validator.
+type ValidatorOverride struct {
+	ValidatorAddress string                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Ratio            cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *ValidatorOverride) Reset()         { *m = ValidatorOverride{} }
+func (m *ValidatorOverride) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOverride) ProtoMessage()    {}
+func (*ValidatorOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_225814d2d9c7e018, []int{1}
+}
+func (m *ValidatorOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOverride.Merge(m, src)
+}
+func (m *ValidatorOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOverride proto.InternalMessageInfo
+
+func (m *ValidatorOverride) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("lyfeblocnetwork.restaking.v1.UnhealthyValidatorPolicy", UnhealthyValidatorPolicy_name, UnhealthyValidatorPolicy_value)
+	proto.RegisterType((*Params)(nil), "lyfeblocnetwork.restaking.v1.Params")
+	proto.RegisterType((*ValidatorOverride)(nil), "lyfeblocnetwork.restaking.v1.ValidatorOverride")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/params.proto", fileDescriptor_225814d2d9c7e018)
+}
+
+var fileDescriptor_225814d2d9c7e018 = []byte{
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0x9a, 0x36, 0x5b, 0xd8, 0x25, 0x55, 0xd8, 0xa4, 0x50, 0xb3, 0xd5, 0xf1, 0x52, 0x60,
+	0x73, 0xb2, 0x46, 0x4e, 0x5b, 0xa0, 0x18, 0x76, 0xb3, 0x23, 0xad, 0x15, 0x66, 0x38, 0x86, 0x92,
+	0x14, 0xe8, 0x06, 0x94, 0xa0, 0x25, 0xda, 0x26, 0x6c, 0x91, 0x02, 0x45, 0x7b, 0xf1, 0x5f, 0xd8,
+	0x69, 0x3f, 0x60, 0x87, 0x1d, 0x77, 0xec, 0x80, 0xfe, 0x86, 0xa1, 0xc7, 0xa2, 0xa7, 0x61, 0x87,
+	0x62, 0x48, 0x0e, 0xdd, 0xcf, 0x18, 0x44, 0x2a, 0x8a, 0x9a, 0xa0, 0xba, 0xa4, 0x17, 0xc3, 0x7a,
+	0xef, 0x7d, 0xdf, 0x47, 0xe9, 0x7b, 0xef, 0x11, 0x6c, 0x8e, 0x67, 0x7d, 0xd2, 0x1b, 0xf3, 0x80,
+	0x11, 0xf9, 0x33, 0x17, 0xa3, 0x86, 0x20, 0x89, 0xc4, 0x23, 0xca, 0x06, 0x8d, 0xe9, 0xfd, 0x46,
+	0x8c, 0x05, 0x8e, 0x12, 0x3b, 0x16, 0x5c, 0x72, 0xf8, 0xc5, 0xb9, 0x52, 0x3b, 0x2f, 0xb5, 0xa7,
+	0xf7, 0xd7, 0x96, 0x71, 0x44, 0x19, 0x6f, 0xa8, 0x5f, 0x0d, 0x58, 0xbb, 0x1d, 0xf0, 0x24, 0xe2,
+	0x09, 0x52, 0x4f, 0x0d, 0xfd, 0x90, 0xa5, 0x56, 0x06, 0x7c, 0xc0, 0x75, 0x3c, 0xfd, 0x97, 0x45,
+	0x1b, 0xa5, 0x87, 0x09, 0x09, 0xe3, 0x11, 0x8a, 0xf9, 0x98, 0x06, 0x33, 0x0d, 0xd8, 0xf8, 0x0d,
+	0x80, 0xf9, 0xae, 0x3a, 0x23, 0x0c, 0x01, 0xc4, 0x13, 0xc9, 0x91, 0x86, 0x10, 0x24, 0xb0, 0xa4,
+	0xdc, 0x32, 0x6a, 0x46, 0x7d, 0xa1, 0xf5, 0xe8, 0xd5, 0xdb, 0xf5, 0xca, 0x3f, 0x6f, 0xd7, 0x3f,
+	0xd7, 0x67, 0x48, 0xc2, 0x91, 0x4d, 0x79, 0x23, 0xc2, 0x72, 0x68, 0xb7, 0xc9, 0x00, 0x07, 0x33,
+	0x87, 0x04, 0x6f, 0x5e, 0x6e, 0x83, 0xec, 0x88, 0x0e, 0x09, 0xfe, 0x78, 0xf7, 0x62, 0xcb, 0xf0,
+	0xcd, 0x94, 0xd1, 0xd7, 0x84, 0x7e, 0xca, 0x07, 0xfb, 0xe0, 0x66, 0x44, 0x19, 0x9a, 0xe2, 0x31,
+	0x0d, 0xb1, 0xe4, 0x22, 0x93, 0xb9, 0x72, 0x29, 0x99, 0xe5, 0x88, 0xb2, 0xa7, 0xa7, 0x8c, 0x67,
+	0x3a, 0xf8, 0xe8, 0x82, 0xce, 0xdc, 0x25, 0x75, 0xf0, 0xd1, 0x39, 0x9d, 0x4d, 0x60, 0x92, 0x98,
+	0x07, 0x43, 0x44, 0x43, 0xc2, 0x24, 0xed, 0x53, 0x22, 0xac, 0xab, 0xa9, 0x88, 0x7f, 0x43, 0xc5,
+	0xbd, 0x3c, 0x0c, 0xef, 0x01, 0x98, 0x1e, 0x49, 0x10, 0x29, 0x66, 0x08, 0x4b, 0x49, 0xa2, 0x58,
+	0x26, 0xd6, 0xb5, 0x9a, 0x51, 0x5f, 0xf4, 0xcd, 0x08, 0x1f, 0xf9, 0x69, 0xa2, 0x99, 0xc5, 0xe1,
+	0x0e, 0x58, 0xd1, 0x95, 0x3d, 0x1c, 0x8c, 0x78, 0xbf, 0x8f, 0x52, 0x5b, 0x47, 0x89, 0x35, 0x5f,
+	0x33, 0xea, 0x57, 0x7d, 0xa8, 0x72, 0x2d, 0x9d, 0x6a, 0xa9, 0x0c, 0x7c, 0x08, 0x6e, 0x69, 0x7e,
+	0xf5, 0xb9, 0x13, 0x14, 0x13, 0xa1, 0x41, 0xd6, 0x27, 0x4a, 0xe3, 0xa6, 0xd2, 0xd0, 0xc9, 0x2e,
+	0x11, 0x0a, 0x05, 0xbf, 0x05, 0xb7, 0x0b, 0x20, 0x34, 0xc0, 0x45, 0xdc, 0xa7, 0x4a, 0x6b, 0xf5,
+	0x0c, 0xf7, 0x18, 0x9f, 0x21, 0x25, 0x58, 0x9b, 0xb0, 0x21, 0xc1, 0x63, 0x39, 0x9c, 0x15, 0xbe,
+	0xb3, 0x6e, 0x2f, 0x6b, 0xa1, 0x66, 0xd4, 0x97, 0x1e, 0x3c, 0xb2, 0xcb, 0x5a, 0xde, 0x3e, 0x3c,
+	0xc5, 0xe7, 0x1f, 0xb5, 0xab, 0xd0, 0xbe, 0x35, 0xf9, 0x40, 0x06, 0xc6, 0xc0, 0x7a, 0xdf, 0xd7,
+	0x80, 0x47, 0x11, 0x4d, 0x12, 0xca, 0x99, 0x05, 0x2e, 0x65, 0xee, 0xad, 0xa2, 0xb9, 0xbb, 0x39,
+	0x2b, 0x1c, 0x82, 0x95, 0xf7, 0x3b, 0x76, 0x12, 0x4b, 0x1a, 0x11, 0xeb, 0xfa, 0xa5, 0xd4, 0x60,
+	0xb1, 0x65, 0x0f, 0x15, 0x23, 0x7c, 0x0e, 0xd2, 0x68, 0xee, 0x05, 0x8e, 0xf8, 0x84, 0x49, 0xeb,
+	0x33, 0xa5, 0xb3, 0x93, 0xe9, 0xac, 0x5e, 0xd4, 0xf1, 0x98, 0x2c, 0x28, 0x78, 0x4c, 0x66, 0xb3,
+	0x17, 0x51, 0x96, 0xd9, 0xd6, 0x54, 0x4c, 0x30, 0x00, 0xab, 0x8c, 0x33, 0xd4, 0xe3, 0x2c, 0x44,
+	0xc5, 0x5d, 0x60, 0x2d, 0x2a, 0xb3, 0x76, 0xca, 0xcd, 0xea, 0x70, 0xd6, 0xe2, 0x2c, 0x74, 0x52,
+	0x60, 0x66, 0x13, 0x64, 0x17, 0x62, 0xf0, 0x27, 0xb0, 0x54, 0xe0, 0xa6, 0x24, 0xb1, 0x96, 0x6a,
+	0x73, 0xf5, 0xeb, 0x0f, 0x36, 0xcb, 0xd9, 0x0b, 0x14, 0xad, 0x85, 0xf4, 0x5d, 0xf5, 0x4b, 0x2c,
+	0x86, 0x79, 0x9c, 0x92, 0x04, 0x36, 0xc1, 0x9d, 0x3e, 0xa6, 0x63, 0x12, 0x66, 0x53, 0x24, 0x88,
+	0x4c, 0xc7, 0x2b, 0x7d, 0x29, 0x3d, 0x1d, 0x37, 0x54, 0xc7, 0xae, 0xe9, 0x22, 0x35, 0x50, 0xfe,
+	0x69, 0x89, 0x9e, 0x92, 0xef, 0xbe, 0xf9, 0xef, 0xf7, 0x75, 0xe3, 0x97, 0x77, 0x2f, 0xb6, 0x36,
+	0xce, 0xef, 0xca, 0xa3, 0xc2, 0xb6, 0xd4, 0x3b, 0x71, 0xe3, 0x4f, 0x03, 0x2c, 0xe7, 0x2e, 0xed,
+	0x4d, 0x89, 0x10, 0x34, 0x24, 0xb0, 0x03, 0x96, 0xcf, 0xba, 0x01, 0x87, 0xa1, 0x20, 0x49, 0x92,
+	0x2d, 0xca, 0x2f, 0xdf, 0xbc, 0xdc, 0xbe, 0x93, 0x39, 0x91, 0x03, 0x9b, 0xba, 0x64, 0x5f, 0x0a,
+	0xca, 0x06, 0xbe, 0x39, 0x3d, 0x17, 0x87, 0x6d, 0x70, 0xed, 0x63, 0x6c, 0x41, 0x4d, 0xb2, 0xf5,
+	0x97, 0x01, 0xac, 0x0f, 0x0d, 0x16, 0xdc, 0x02, 0x5f, 0x1d, 0x76, 0x9e, 0xb8, 0xcd, 0xf6, 0xc1,
+	0x93, 0x67, 0xe8, 0x69, 0xb3, 0xed, 0x39, 0xcd, 0x83, 0x3d, 0x1f, 0x75, 0xf7, 0xda, 0xde, 0xee,
+	0x33, 0x74, 0xd8, 0xd9, 0xef, 0xba, 0xbb, 0xde, 0xf7, 0x9e, 0xeb, 0x98, 0x15, 0x78, 0x17, 0xac,
+	0x97, 0xd4, 0xee, 0xff, 0xe0, 0x75, 0x4d, 0x03, 0xee, 0x80, 0x7b, 0x65, 0x45, 0x6e, 0xc7, 0x41,
+	0x07, 0x7b, 0xc8, 0x71, 0xdb, 0xee, 0xe3, 0x34, 0x61, 0x5e, 0x81, 0x5f, 0x83, 0xbb, 0x25, 0x08,
+	0xdf, 0x75, 0x3c, 0xdf, 0xdd, 0x3d, 0x30, 0xe7, 0x5a, 0xcf, 0x5f, 0x1d, 0x57, 0x8d, 0xd7, 0xc7,
+	0x55, 0xe3, 0xdf, 0xe3, 0xaa, 0xf1, 0xeb, 0x49, 0xb5, 0xf2, 0xfa, 0xa4, 0x5a, 0xf9, 0xfb, 0xa4,
+	0x5a, 0xf9, 0xd1, 0x19, 0x50, 0x39, 0x9c, 0xf4, 0xec, 0x80, 0x47, 0xea, 0xc6, 0x1b, 0x73, 0x1e,
+	0x53, 0x16, 0xe4, 0xb7, 0xdf, 0xf6, 0xa9, 0xa5, 0x65, 0xd7, 0x61, 0x6f, 0x5e, 0x5d, 0x81, 0x0f,
+	0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x08, 0x07, 0xcf, 0x86, 0xc2, 0x07, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AutoRestakeRatio.Equal(that1.AutoRestakeRatio) {
+		return false
+	}
+	if !this.MinValidatorRatio.Equal(that1.MinValidatorRatio) {
+		return false
+	}
+	if !this.MaxValidatorRatio.Equal(that1.MaxValidatorRatio) {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if this.MaxRetryAttempts != that1.MaxRetryAttempts {
+		return false
+	}
+	if this.RetryBackoffBlocks != that1.RetryBackoffBlocks {
+		return false
+	}
+	if this.MaxRestakesPerBlock != that1.MaxRestakesPerBlock {
+		return false
+	}
+	if this.MaxRestakeGasPerBlock != that1.MaxRestakeGasPerBlock {
+		return false
+	}
+	if this.UnhealthyValidatorPolicy != that1.UnhealthyValidatorPolicy {
+		return false
+	}
+	if !this.MaxValidatorCommission.Equal(that1.MaxValidatorCommission) {
+		return false
+	}
+	if !this.MinValidatorUptime.Equal(that1.MinValidatorUptime) {
+		return false
+	}
+	if !this.MinRestakeAmount.Equal(that1.MinRestakeAmount) {
+		return false
+	}
+	if this.NonBondDenomPolicy != that1.NonBondDenomPolicy {
+		return false
+	}
+	if len(this.DenomPolicies) != len(that1.DenomPolicies) {
+		return false
+	}
+	for i := range this.DenomPolicies {
+		if !this.DenomPolicies[i].Equal(&that1.DenomPolicies[i]) {
+			return false
+		}
+	}
+	if this.FailedRetryRetentionBlocks != that1.FailedRetryRetentionBlocks {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedRetryRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FailedRetryRetentionBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DenomPolicies) > 0 {
+		for iNdEx := len(m.DenomPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.NonBondDenomPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NonBondDenomPolicy))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.MinRestakeAmount.Size()
+		i -= size
+		if _, err := m.MinRestakeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinValidatorUptime.Size()
+		i -= size
+		if _, err := m.MinValidatorUptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxValidatorCommission.Size()
+		i -= size
+		if _, err := m.MaxValidatorCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.UnhealthyValidatorPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnhealthyValidatorPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxRestakeGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRestakeGasPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRestakesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRestakesPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RetryBackoffBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryBackoffBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRetryAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetryAttempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxValidatorRatio.Size()
+		i -= size
+		if _, err := m.MaxValidatorRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinValidatorRatio.Size()
+		i -= size
+		if _, err := m.MinValidatorRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AutoRestakeRatio.Size()
+		i -= size
+		if _, err := m.AutoRestakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AutoRestakeRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinValidatorRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxValidatorRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxRetryAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetryAttempts))
+	}
+	if m.RetryBackoffBlocks != 0 {
+		n += 1 + sovParams(uint64(m.RetryBackoffBlocks))
+	}
+	if m.MaxRestakesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRestakesPerBlock))
+	}
+	if m.MaxRestakeGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRestakeGasPerBlock))
+	}
+	if m.UnhealthyValidatorPolicy != 0 {
+		n += 1 + sovParams(uint64(m.UnhealthyValidatorPolicy))
+	}
+	l = m.MaxValidatorCommission.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinValidatorUptime.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinRestakeAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.NonBondDenomPolicy != 0 {
+		n += 1 + sovParams(uint64(m.NonBondDenomPolicy))
+	}
+	if len(m.DenomPolicies) > 0 {
+		for _, e := range m.DenomPolicies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FailedRetryRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.FailedRetryRetentionBlocks))
+	}
+	return n
+}
+
+func (m *ValidatorOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoRestakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidatorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryAttempts", wireType)
+			}
+			m.MaxRetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffBlocks", wireType)
+			}
+			m.RetryBackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestakesPerBlock", wireType)
+			}
+			m.MaxRestakesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestakesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestakeGasPerBlock", wireType)
+			}
+			m.MaxRestakeGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestakeGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthyValidatorPolicy", wireType)
+			}
+			m.UnhealthyValidatorPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnhealthyValidatorPolicy |= UnhealthyValidatorPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorUptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidatorUptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRestakeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRestakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonBondDenomPolicy", wireType)
+			}
+			m.NonBondDenomPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonBondDenomPolicy |= NonBondDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPolicies = append(m.DenomPolicies, DenomPolicy{})
+			if err := m.DenomPolicies[len(m.DenomPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRetryRetentionBlocks", wireType)
+			}
+			m.FailedRetryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedRetryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
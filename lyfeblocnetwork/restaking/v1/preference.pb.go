@@ -0,0 +1,1095 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/preference.proto
+
+package v1
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelegatorPreference holds a delegator's auto-restake choices. It is consulted
+// before any validator override and the global ratio.
+type DelegatorPreference struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// disabled opts the delegator out of auto-restake for every validator that
+	// has no entry in validator_preferences.
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// ratio, when set, replaces the validator override and global ratio for
+	// every validator that has no entry in validator_preferences.
+	Ratio *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio,omitempty"`
+	// validator_preferences are per-validator entries that take precedence over
+	// the delegator-wide fields above.
+	ValidatorPreferences []ValidatorPreference `protobuf:"bytes,4,rep,name=validator_preferences,json=validatorPreferences,proto3" json:"validator_preferences"`
+	// fallback_validator_address receives the restakes redirected away from an
+	// unhealthy validator under the redirect policy.
+	FallbackValidatorAddress string `protobuf:"bytes,5,opt,name=fallback_validator_address,json=fallbackValidatorAddress,proto3" json:"fallback_validator_address,omitempty"`
+	// diversification_targets, when set, spread every restake across the listed
+	// validators by weight instead of delegating to the validator that paid the
+	// rewards.
+	DiversificationTargets []DiversificationTarget `protobuf:"bytes,6,rep,name=diversification_targets,json=diversificationTargets,proto3" json:"diversification_targets"`
+}
+
+func (m *DelegatorPreference) Reset()         { *m = DelegatorPreference{} }
+func (m *DelegatorPreference) String() string { return proto.CompactTextString(m) }
+func (*DelegatorPreference) ProtoMessage()    {}
+func (*DelegatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ed8cc32f97d6101, []int{0}
+}
+func (m *DelegatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorPreference.Merge(m, src)
+}
+func (m *DelegatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorPreference proto.InternalMessageInfo
+
+func (m *DelegatorPreference) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorPreference) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *DelegatorPreference) GetValidatorPreferences() []ValidatorPreference {
+	if m != nil {
+		return m.ValidatorPreferences
+	}
+	return nil
+}
+
+func (m *DelegatorPreference) GetFallbackValidatorAddress() string {
+	if m != nil {
+		return m.FallbackValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorPreference) GetDiversificationTargets() []DiversificationTarget {
+	if m != nil {
+		return m.DiversificationTargets
+	}
+	return nil
+}
+
+// DiversificationTarget is a validator in a delegator's diversification set.
+type DiversificationTarget struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// weight is the validator's share of each restake relative to the other
+	// targets. It must be positive.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *DiversificationTarget) Reset()         { *m = DiversificationTarget{} }
+func (m *DiversificationTarget) String() string { return proto.CompactTextString(m) }
+func (*DiversificationTarget) ProtoMessage()    {}
+func (*DiversificationTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ed8cc32f97d6101, []int{1}
+}
+func (m *DiversificationTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiversificationTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiversificationTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiversificationTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiversificationTarget.Merge(m, src)
+}
+func (m *DiversificationTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiversificationTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiversificationTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiversificationTarget proto.InternalMessageInfo
+
+func (m *DiversificationTarget) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DiversificationTarget) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// ValidatorPreference is a delegator's auto-restake choice for one validator.
+// Exactly one of disabled or ratio must be set.
+type ValidatorPreference struct {
+	ValidatorAddress string                       `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Disabled         bool                         `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Ratio            *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio,omitempty"`
+}
+
+func (m *ValidatorPreference) Reset()         { *m = ValidatorPreference{} }
+func (m *ValidatorPreference) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPreference) ProtoMessage()    {}
+func (*ValidatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ed8cc32f97d6101, []int{2}
+}
+func (m *ValidatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPreference.Merge(m, src)
+}
+func (m *ValidatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPreference proto.InternalMessageInfo
+
+func (m *ValidatorPreference) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPreference) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*DelegatorPreference)(nil), "lyfeblocnetwork.restaking.v1.DelegatorPreference")
+	proto.RegisterType((*DiversificationTarget)(nil), "lyfeblocnetwork.restaking.v1.DiversificationTarget")
+	proto.RegisterType((*ValidatorPreference)(nil), "lyfeblocnetwork.restaking.v1.ValidatorPreference")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/preference.proto", fileDescriptor_2ed8cc32f97d6101)
+}
+
+var fileDescriptor_2ed8cc32f97d6101 = []byte{
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0x9a, 0x46, 0x65, 0xb9, 0xb4, 0x6e, 0x5a, 0x4c, 0x00, 0x37, 0xe4, 0x94, 0x8b,
+	0x6d, 0x85, 0x3e, 0x01, 0x21, 0xdc, 0x10, 0x42, 0x06, 0x71, 0xe0, 0x80, 0xb5, 0xde, 0x9d, 0x6c,
+	0x56, 0xd9, 0x78, 0xa3, 0xdd, 0xc5, 0x55, 0x4f, 0xf0, 0x08, 0x3c, 0x4c, 0x9e, 0x01, 0xf5, 0x58,
+	0xe5, 0x84, 0x38, 0x54, 0x28, 0x79, 0x11, 0x14, 0x7f, 0x45, 0x4d, 0xa3, 0x88, 0x03, 0xbd, 0x79,
+	0x3e, 0xfe, 0x33, 0xfb, 0x9b, 0xd9, 0x35, 0xf2, 0xc4, 0xe5, 0x10, 0x62, 0x21, 0x49, 0x02, 0xe6,
+	0x42, 0xaa, 0x71, 0xa0, 0x40, 0x1b, 0x3c, 0xe6, 0x09, 0x0b, 0xd2, 0x5e, 0x30, 0x55, 0x30, 0x04,
+	0x05, 0x09, 0x01, 0x7f, 0xaa, 0xa4, 0x91, 0xf6, 0xb3, 0x8d, 0x74, 0xbf, 0x4a, 0xf7, 0xd3, 0x5e,
+	0xeb, 0x09, 0x91, 0x7a, 0x22, 0x75, 0x94, 0xe5, 0x06, 0xb9, 0x91, 0x0b, 0x5b, 0x4d, 0x26, 0x99,
+	0xcc, 0xfd, 0xab, 0xaf, 0xdc, 0xdb, 0xf9, 0x5e, 0x47, 0xc7, 0x03, 0x10, 0xc0, 0xb0, 0x91, 0xea,
+	0x7d, 0xd5, 0xcc, 0x7e, 0x83, 0x8e, 0x68, 0xe9, 0x8e, 0x30, 0xa5, 0x0a, 0xb4, 0x76, 0xac, 0xb6,
+	0xd5, 0x7d, 0xd8, 0x77, 0xe6, 0x33, 0xaf, 0x59, 0x94, 0x7e, 0x95, 0x47, 0x3e, 0x18, 0xc5, 0x13,
+	0x16, 0x1e, 0x56, 0x92, 0xc2, 0x6f, 0xb7, 0xd0, 0x01, 0xe5, 0x1a, 0xc7, 0x02, 0xa8, 0xf3, 0xa0,
+	0x6d, 0x75, 0x0f, 0xc2, 0xca, 0xb6, 0x5f, 0xa3, 0x7d, 0x85, 0x0d, 0x97, 0xce, 0x5e, 0x56, 0xd6,
+	0xfb, 0x7d, 0x73, 0xf6, 0x34, 0x2f, 0xab, 0xe9, 0xd8, 0xe7, 0x32, 0x98, 0x60, 0x33, 0xf2, 0xdf,
+	0x02, 0xc3, 0xe4, 0x72, 0x00, 0x64, 0x3e, 0xf3, 0x50, 0xd1, 0x75, 0x00, 0x24, 0xcc, 0xb5, 0xb6,
+	0x40, 0x27, 0x29, 0x16, 0x9c, 0x66, 0xe7, 0x5c, 0x0f, 0x4b, 0x3b, 0xf5, 0xf6, 0x5e, 0xf7, 0xd1,
+	0xcb, 0x9e, 0xbf, 0x6b, 0x5c, 0xfe, 0xa7, 0x52, 0xba, 0x26, 0xef, 0xd7, 0xaf, 0x6e, 0xce, 0x6a,
+	0x61, 0x33, 0xbd, 0x1b, 0xd2, 0x76, 0x84, 0x5a, 0x43, 0x2c, 0x44, 0x8c, 0xc9, 0x38, 0x5a, 0xb7,
+	0x2d, 0xc7, 0xb3, 0x9f, 0x71, 0xbc, 0x98, 0xcf, 0xbc, 0xe7, 0xc5, 0x41, 0xab, 0xfa, 0xb7, 0xe7,
+	0xe4, 0x94, 0x45, 0x36, 0xe3, 0xb6, 0x42, 0x8f, 0x29, 0x4f, 0x41, 0x69, 0x3e, 0xe4, 0x64, 0x05,
+	0x98, 0x44, 0x06, 0x2b, 0x06, 0x46, 0x3b, 0x8d, 0x0c, 0xe8, 0x7c, 0x37, 0xd0, 0xe0, 0xb6, 0xf8,
+	0x63, 0xa6, 0x2d, 0x90, 0x4e, 0xe9, 0xb6, 0xa0, 0xee, 0x7c, 0x43, 0x27, 0x5b, 0x65, 0xf6, 0x3b,
+	0x74, 0x74, 0x17, 0xd2, 0xfa, 0x57, 0xc8, 0xc3, 0x74, 0x13, 0xee, 0x14, 0x35, 0x2e, 0x80, 0xb3,
+	0x91, 0xc9, 0xae, 0x42, 0x3d, 0x2c, 0xac, 0xce, 0x4f, 0x0b, 0x1d, 0x6f, 0xd9, 0xc4, 0x7f, 0xef,
+	0x7f, 0xdf, 0x97, 0xb1, 0xff, 0xe5, 0x6a, 0xe1, 0x5a, 0xd7, 0x0b, 0xd7, 0xfa, 0xb3, 0x70, 0xad,
+	0x1f, 0x4b, 0xb7, 0x76, 0xbd, 0x74, 0x6b, 0xbf, 0x96, 0x6e, 0xed, 0xf3, 0x80, 0x71, 0x33, 0xfa,
+	0x1a, 0xfb, 0x44, 0x4e, 0x82, 0xd5, 0x02, 0x85, 0x94, 0x53, 0x9e, 0x90, 0xa0, 0x5c, 0xa6, 0x57,
+	0x3e, 0xfe, 0x5d, 0x3f, 0x83, 0xb8, 0x91, 0xbd, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x3c, 0xb8, 0x03, 0x80, 0x33, 0x04, 0x00, 0x00,
+}
+
+func (m *DelegatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiversificationTargets) > 0 {
+		for iNdEx := len(m.DiversificationTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DiversificationTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPreference(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FallbackValidatorAddress) > 0 {
+		i -= len(m.FallbackValidatorAddress)
+		copy(dAtA[i:], m.FallbackValidatorAddress)
+		i = encodeVarintPreference(dAtA, i, uint64(len(m.FallbackValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for iNdEx := len(m.ValidatorPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPreference(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Ratio != nil {
+		{
+			size := m.Ratio.Size()
+			i -= size
+			if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPreference(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintPreference(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiversificationTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiversificationTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiversificationTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintPreference(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintPreference(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ratio != nil {
+		{
+			size := m.Ratio.Size()
+			i -= size
+			if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPreference(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintPreference(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPreference(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPreference(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPreference(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.Ratio != nil {
+		l = m.Ratio.Size()
+		n += 1 + l + sovPreference(uint64(l))
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for _, e := range m.ValidatorPreferences {
+			l = e.Size()
+			n += 1 + l + sovPreference(uint64(l))
+		}
+	}
+	l = len(m.FallbackValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPreference(uint64(l))
+	}
+	if len(m.DiversificationTargets) > 0 {
+		for _, e := range m.DiversificationTargets {
+			l = e.Size()
+			n += 1 + l + sovPreference(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DiversificationTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPreference(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovPreference(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *ValidatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPreference(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.Ratio != nil {
+		l = m.Ratio.Size()
+		n += 1 + l + sovPreference(uint64(l))
+	}
+	return n
+}
+
+func sovPreference(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPreference(x uint64) (n int) {
+	return sovPreference(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPreference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Ratio = &v
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPreferences = append(m.ValidatorPreferences, ValidatorPreference{})
+			if err := m.ValidatorPreferences[len(m.ValidatorPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiversificationTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiversificationTargets = append(m.DiversificationTargets, DiversificationTarget{})
+			if err := m.DiversificationTargets[len(m.DiversificationTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPreference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiversificationTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPreference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiversificationTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiversificationTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPreference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPreference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPreference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Ratio = &v
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPreference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPreference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPreference(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPreference
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPreference
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPreference
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPreference
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPreference
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPreference        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPreference          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPreference = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,7 +5,14 @@ package v1
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -66,6 +73,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
 type QueryParamsResponse struct {
 	AutoRestakeRatio string `protobuf:"bytes,1,opt,name=auto_restake_ratio,json=autoRestakeRatio,proto3" json:"auto_restake_ratio,omitempty"`
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -108,211 +117,5765 @@ func (m *QueryParamsResponse) GetAutoRestakeRatio() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryParamsResponse")
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func init() {
-	proto.RegisterFile("lyfeblocnetwork/restaking/v1/query.proto", fileDescriptor_2b5acc48c002eb49)
+// QueryValidatorOverrideRequest is the request type for the
+// Query/ValidatorOverride RPC method.
+type QueryValidatorOverrideRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-var fileDescriptor_2b5acc48c002eb49 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0xa9, 0x4c, 0x4b,
-	0x4d, 0xca, 0xc9, 0x4f, 0xce, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2f, 0x4a, 0x2d, 0x2e,
-	0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x41, 0x53, 0xa9, 0x07, 0x57, 0xa9, 0x57, 0x66, 0x28, 0x25,
-	0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f,
-	0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0xd1, 0xab, 0x24, 0xc2, 0x25, 0x14, 0x08, 0x32, 0x2a,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x38, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0xc9, 0x99, 0x4b,
-	0x18, 0x45, 0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x48, 0x87, 0x4b, 0x28, 0xb1, 0xb4, 0x24,
-	0x3f, 0x1e, 0x62, 0x7e, 0x6a, 0x7c, 0x11, 0xc8, 0x28, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20,
-	0x01, 0x90, 0x4c, 0x10, 0x44, 0x22, 0x08, 0x24, 0x6e, 0xb4, 0x94, 0x91, 0x8b, 0x15, 0x6c, 0x8a,
-	0xd0, 0x6c, 0x46, 0x2e, 0x36, 0x88, 0x51, 0x42, 0x06, 0x7a, 0xf8, 0x1c, 0xab, 0x87, 0xe9, 0x16,
-	0x29, 0x43, 0x12, 0x74, 0x40, 0xdc, 0xa9, 0xa4, 0xd3, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x35, 0x21,
-	0x15, 0x7d, 0xbc, 0x61, 0x58, 0x00, 0xd6, 0xe5, 0x14, 0x77, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x2e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x60,
-	0x93, 0x72, 0xf2, 0xf3, 0x0b, 0x32, 0xf3, 0x92, 0xe1, 0xa6, 0xea, 0xc2, 0x8c, 0xc5, 0x67, 0x4d,
-	0x12, 0x1b, 0x38, 0xa4, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x64, 0xeb, 0xa9, 0xd1,
-	0x01, 0x00, 0x00,
+func (m *QueryValidatorOverrideRequest) Reset()         { *m = QueryValidatorOverrideRequest{} }
+func (m *QueryValidatorOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOverrideRequest) ProtoMessage()    {}
+func (*QueryValidatorOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{2}
+}
+func (m *QueryValidatorOverrideRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOverrideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOverrideRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOverrideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOverrideRequest.Merge(m, src)
+}
+func (m *QueryValidatorOverrideRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOverrideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOverrideRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryValidatorOverrideRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryValidatorOverrideRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryValidatorOverrideResponse is the response type for the
+// Query/ValidatorOverride RPC method.
+type QueryValidatorOverrideResponse struct {
+	Override ValidatorOverride `protobuf:"bytes,1,opt,name=override,proto3" json:"override"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryValidatorOverrideResponse) Reset()         { *m = QueryValidatorOverrideResponse{} }
+func (m *QueryValidatorOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOverrideResponse) ProtoMessage()    {}
+func (*QueryValidatorOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{3}
+}
+func (m *QueryValidatorOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOverrideResponse.Merge(m, src)
+}
+func (m *QueryValidatorOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOverrideResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorOverrideResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOverrideResponse) GetOverride() ValidatorOverride {
+	if m != nil {
+		return m.Override
 	}
-	return out, nil
+	return ValidatorOverride{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryValidatorOverridesRequest is the request type for the
+// Query/ValidatorOverrides RPC method.
+type QueryValidatorOverridesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryValidatorOverridesRequest) Reset()         { *m = QueryValidatorOverridesRequest{} }
+func (m *QueryValidatorOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOverridesRequest) ProtoMessage()    {}
+func (*QueryValidatorOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{4}
+}
+func (m *QueryValidatorOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOverridesRequest.Merge(m, src)
+}
+func (m *QueryValidatorOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOverridesRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryValidatorOverridesRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorOverridesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryValidatorOverridesResponse is the response type for the
+// Query/ValidatorOverrides RPC method.
+type QueryValidatorOverridesResponse struct {
+	Overrides  []ValidatorOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+func (m *QueryValidatorOverridesResponse) Reset()         { *m = QueryValidatorOverridesResponse{} }
+func (m *QueryValidatorOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOverridesResponse) ProtoMessage()    {}
+func (*QueryValidatorOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{5}
+}
+func (m *QueryValidatorOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/Params",
+}
+func (m *QueryValidatorOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOverridesResponse.Merge(m, src)
+}
+func (m *QueryValidatorOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOverridesResponse) GetOverrides() []ValidatorOverride {
+	if m != nil {
+		return m.Overrides
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+func (m *QueryValidatorOverridesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lyfeblocnetwork.restaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lyfeblocnetwork/restaking/v1/query.proto",
+// QueryDelegatorPreferenceRequest is the request type for the
+// Query/DelegatorPreference RPC method.
+type QueryDelegatorPreferenceRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPreferenceRequest) Reset()         { *m = QueryDelegatorPreferenceRequest{} }
+func (m *QueryDelegatorPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPreferenceRequest) ProtoMessage()    {}
+func (*QueryDelegatorPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{6}
+}
+func (m *QueryDelegatorPreferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPreferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPreferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDelegatorPreferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPreferenceRequest.Merge(m, src)
+}
+func (m *QueryDelegatorPreferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPreferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPreferenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDelegatorPreferenceRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorPreferenceRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryDelegatorPreferenceResponse is the response type for the
+// Query/DelegatorPreference RPC method.
+type QueryDelegatorPreferenceResponse struct {
+	Preference DelegatorPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPreferenceResponse) Reset()         { *m = QueryDelegatorPreferenceResponse{} }
+func (m *QueryDelegatorPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPreferenceResponse) ProtoMessage()    {}
+func (*QueryDelegatorPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{7}
+}
+func (m *QueryDelegatorPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDelegatorPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPreferenceResponse.Merge(m, src)
+}
+func (m *QueryDelegatorPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPreferenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDelegatorPreferenceResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorPreferenceResponse) GetPreference() DelegatorPreference {
+	if m != nil {
+		return m.Preference
+	}
+	return DelegatorPreference{}
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AutoRestakeRatio) > 0 {
-		i -= len(m.AutoRestakeRatio)
-		copy(dAtA[i:], m.AutoRestakeRatio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoRestakeRatio)))
-		i--
-		dAtA[i] = 0xa
+// QueryRestakeHistoryRequest is the request type for the
+// Query/RestakeHistory RPC method.
+type QueryRestakeHistoryRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRestakeHistoryRequest) Reset()         { *m = QueryRestakeHistoryRequest{} }
+func (m *QueryRestakeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakeHistoryRequest) ProtoMessage()    {}
+func (*QueryRestakeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{8}
+}
+func (m *QueryRestakeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRestakeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakeHistoryRequest.Merge(m, src)
+}
+func (m *QueryRestakeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakeHistoryRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRestakeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRestakeHistoryRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryRestakeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRestakeHistoryResponse is the response type for the
+// Query/RestakeHistory RPC method.
+type QueryRestakeHistoryResponse struct {
+	Records    []RestakeRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRestakeHistoryResponse) Reset()         { *m = QueryRestakeHistoryResponse{} }
+func (m *QueryRestakeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakeHistoryResponse) ProtoMessage()    {}
+func (*QueryRestakeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{9}
+}
+func (m *QueryRestakeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakeHistoryResponse.Merge(m, src)
+}
+func (m *QueryRestakeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRestakeHistoryResponse) GetRecords() []RestakeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryRestakeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorStatsRequest is the request type for the
+// Query/DelegatorStats RPC method.
+type QueryDelegatorStatsRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorStatsRequest) Reset()         { *m = QueryDelegatorStatsRequest{} }
+func (m *QueryDelegatorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorStatsRequest) ProtoMessage()    {}
+func (*QueryDelegatorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{10}
+}
+func (m *QueryDelegatorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorStatsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorStatsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryDelegatorStatsResponse is the response type for the
+// Query/DelegatorStats RPC method.
+type QueryDelegatorStatsResponse struct {
+	// restaked is the total auto-restaked for the delegator.
+	Restaked types.Coin `protobuf:"bytes,1,opt,name=restaked,proto3" json:"restaked"`
+}
+
+func (m *QueryDelegatorStatsResponse) Reset()         { *m = QueryDelegatorStatsResponse{} }
+func (m *QueryDelegatorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorStatsResponse) ProtoMessage()    {}
+func (*QueryDelegatorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{11}
+}
+func (m *QueryDelegatorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorStatsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorStatsResponse) GetRestaked() types.Coin {
+	if m != nil {
+		return m.Restaked
+	}
+	return types.Coin{}
+}
+
+// QueryValidatorStatsRequest is the request type for the
+// Query/ValidatorStats RPC method.
+type QueryValidatorStatsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorStatsRequest) Reset()         { *m = QueryValidatorStatsRequest{} }
+func (m *QueryValidatorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorStatsRequest) ProtoMessage()    {}
+func (*QueryValidatorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{12}
+}
+func (m *QueryValidatorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorStatsRequest.Merge(m, src)
+}
+func (m *QueryValidatorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorStatsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorStatsResponse is the response type for the
+// Query/ValidatorStats RPC method.
+type QueryValidatorStatsResponse struct {
+	// restaked is the total auto-restaked to the validator.
+	Restaked types.Coin `protobuf:"bytes,1,opt,name=restaked,proto3" json:"restaked"`
+}
+
+func (m *QueryValidatorStatsResponse) Reset()         { *m = QueryValidatorStatsResponse{} }
+func (m *QueryValidatorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorStatsResponse) ProtoMessage()    {}
+func (*QueryValidatorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{13}
+}
+func (m *QueryValidatorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorStatsResponse.Merge(m, src)
+}
+func (m *QueryValidatorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorStatsResponse) GetRestaked() types.Coin {
+	if m != nil {
+		return m.Restaked
+	}
+	return types.Coin{}
+}
+
+// QueryStatsRequest is the request type for the Query/Stats RPC method.
+type QueryStatsRequest struct {
+}
+
+func (m *QueryStatsRequest) Reset()         { *m = QueryStatsRequest{} }
+func (m *QueryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsRequest) ProtoMessage()    {}
+func (*QueryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{14}
+}
+func (m *QueryStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsRequest.Merge(m, src)
+}
+func (m *QueryStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatsRequest proto.InternalMessageInfo
+
+// QueryStatsResponse is the response type for the Query/Stats RPC method.
+type QueryStatsResponse struct {
+	// total_restaked is the total auto-restaked across the chain.
+	TotalRestaked types.Coin `protobuf:"bytes,1,opt,name=total_restaked,json=totalRestaked,proto3" json:"total_restaked"`
+	// active_restakers is the number of delegators opted in to auto-restaking,
+	// that is with a stored preference that is not disabled.
+	ActiveRestakers uint64 `protobuf:"varint,2,opt,name=active_restakers,json=activeRestakers,proto3" json:"active_restakers,omitempty"`
+}
+
+func (m *QueryStatsResponse) Reset()         { *m = QueryStatsResponse{} }
+func (m *QueryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsResponse) ProtoMessage()    {}
+func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{15}
+}
+func (m *QueryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsResponse.Merge(m, src)
+}
+func (m *QueryStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatsResponse proto.InternalMessageInfo
+
+func (m *QueryStatsResponse) GetTotalRestaked() types.Coin {
+	if m != nil {
+		return m.TotalRestaked
+	}
+	return types.Coin{}
+}
+
+func (m *QueryStatsResponse) GetActiveRestakers() uint64 {
+	if m != nil {
+		return m.ActiveRestakers
+	}
+	return 0
+}
+
+// QueryRetryEntriesRequest is the request type for the
+// Query/RetryEntries RPC method.
+type QueryRetryEntriesRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRetryEntriesRequest) Reset()         { *m = QueryRetryEntriesRequest{} }
+func (m *QueryRetryEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRetryEntriesRequest) ProtoMessage()    {}
+func (*QueryRetryEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{16}
+}
+func (m *QueryRetryEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetryEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetryEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRetryEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetryEntriesRequest.Merge(m, src)
+}
+func (m *QueryRetryEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetryEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetryEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetryEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryRetryEntriesRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryRetryEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRetryEntriesResponse is the response type for the
+// Query/RetryEntries RPC method.
+type QueryRetryEntriesResponse struct {
+	Entries    []RetryEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRetryEntriesResponse) Reset()         { *m = QueryRetryEntriesResponse{} }
+func (m *QueryRetryEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRetryEntriesResponse) ProtoMessage()    {}
+func (*QueryRetryEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{17}
+}
+func (m *QueryRetryEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetryEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetryEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRetryEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetryEntriesResponse.Merge(m, src)
+}
+func (m *QueryRetryEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetryEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetryEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetryEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryRetryEntriesResponse) GetEntries() []RetryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryRetryEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySplitPreviewRequest is the request type for the
+// Query/SplitPreview RPC method.
+type QuerySplitPreviewRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator paying the rewards. It resolves the
+	// auto-restake ratio and receives the restake when the delegator has no
+	// diversification targets.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rewards is the reward amount in the bond denom.
+	Rewards cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=rewards,proto3,customtype=cosmossdk.io/math.Int" json:"rewards"`
+}
+
+func (m *QuerySplitPreviewRequest) Reset()         { *m = QuerySplitPreviewRequest{} }
+func (m *QuerySplitPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySplitPreviewRequest) ProtoMessage()    {}
+func (*QuerySplitPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{18}
+}
+func (m *QuerySplitPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySplitPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySplitPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySplitPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySplitPreviewRequest.Merge(m, src)
+}
+func (m *QuerySplitPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySplitPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySplitPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySplitPreviewRequest proto.InternalMessageInfo
+
+func (m *QuerySplitPreviewRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySplitPreviewRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QuerySplitPreviewResponse is the response type for the
+// Query/SplitPreview RPC method.
+type QuerySplitPreviewResponse struct {
+	// restaked is the portion of the rewards that would be restaked, along with
+	// the delegation's carry-over.
+	Restaked types.Coin `protobuf:"bytes,1,opt,name=restaked,proto3" json:"restaked"`
+	// splits is how restaked would be delegated. It is empty when nothing would
+	// be restaked.
+	Splits []RestakeSplit `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *QuerySplitPreviewResponse) Reset()         { *m = QuerySplitPreviewResponse{} }
+func (m *QuerySplitPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySplitPreviewResponse) ProtoMessage()    {}
+func (*QuerySplitPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{19}
+}
+func (m *QuerySplitPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySplitPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySplitPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySplitPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySplitPreviewResponse.Merge(m, src)
+}
+func (m *QuerySplitPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySplitPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySplitPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySplitPreviewResponse proto.InternalMessageInfo
+
+func (m *QuerySplitPreviewResponse) GetRestaked() types.Coin {
+	if m != nil {
+		return m.Restaked
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySplitPreviewResponse) GetSplits() []RestakeSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+// RestakeSplit is the part of a restake delegated to one validator.
+type RestakeSplit struct {
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *RestakeSplit) Reset()         { *m = RestakeSplit{} }
+func (m *RestakeSplit) String() string { return proto.CompactTextString(m) }
+func (*RestakeSplit) ProtoMessage()    {}
+func (*RestakeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{20}
+}
+func (m *RestakeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakeSplit.Merge(m, src)
+}
+func (m *RestakeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakeSplit proto.InternalMessageInfo
+
+func (m *RestakeSplit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RestakeSplit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// QuerySimulateAutoRestakeRequest is the request type for the
+// Query/SimulateAutoRestake RPC method.
+type QuerySimulateAutoRestakeRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QuerySimulateAutoRestakeRequest) Reset()         { *m = QuerySimulateAutoRestakeRequest{} }
+func (m *QuerySimulateAutoRestakeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAutoRestakeRequest) ProtoMessage()    {}
+func (*QuerySimulateAutoRestakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{21}
+}
+func (m *QuerySimulateAutoRestakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAutoRestakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAutoRestakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateAutoRestakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAutoRestakeRequest.Merge(m, src)
+}
+func (m *QuerySimulateAutoRestakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAutoRestakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAutoRestakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateAutoRestakeRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateAutoRestakeRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySimulateAutoRestakeRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QuerySimulateAutoRestakeResponse is the response type for the
+// Query/SimulateAutoRestake RPC method.
+type QuerySimulateAutoRestakeResponse struct {
+	// rewards are the rewards a withdrawal would pay out now.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// restaked is the portion of the rewards that would be restaked at the end
+	// of the block, along with the delegation's carry-over.
+	Restaked types.Coin `protobuf:"bytes,2,opt,name=restaked,proto3" json:"restaked"`
+	// liquid is what the withdrawal would leave with the delegator, including
+	// any restake that would fail and be queued for retry.
+	Liquid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=liquid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquid"`
+	// splits is how restaked would be delegated.
+	Splits []RestakeSplit `protobuf:"bytes,4,rep,name=splits,proto3" json:"splits"`
+	// ratio is the resolved auto-restake ratio and ratio_source where it came
+	// from.
+	Ratio       cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+	RatioSource RatioSource                 `protobuf:"varint,6,opt,name=ratio_source,json=ratioSource,proto3,enum=lyfeblocnetwork.restaking.v1.RatioSource" json:"ratio_source,omitempty"`
+	// carry_over is the delegation's carry-over after the withdrawal. It grows
+	// when the restake falls below min_restake_amount and is otherwise restaked
+	// along with the rewards.
+	CarryOver types.Coin `protobuf:"bytes,7,opt,name=carry_over,json=carryOver,proto3" json:"carry_over"`
+	// non_bond_denoms is the policy that would apply to the portion of the
+	// rewards in every denom other than the bond denom. The policies are not
+	// executed by the dry run, so one that would fail is still reported.
+	NonBondDenoms []NonBondDenomOutcome `protobuf:"bytes,8,rep,name=non_bond_denoms,json=nonBondDenoms,proto3" json:"non_bond_denoms"`
+}
+
+func (m *QuerySimulateAutoRestakeResponse) Reset()         { *m = QuerySimulateAutoRestakeResponse{} }
+func (m *QuerySimulateAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAutoRestakeResponse) ProtoMessage()    {}
+func (*QuerySimulateAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{22}
+}
+func (m *QuerySimulateAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAutoRestakeResponse.Merge(m, src)
+}
+func (m *QuerySimulateAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateAutoRestakeResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateAutoRestakeResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QuerySimulateAutoRestakeResponse) GetRestaked() types.Coin {
+	if m != nil {
+		return m.Restaked
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateAutoRestakeResponse) GetLiquid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquid
+	}
+	return nil
+}
+
+func (m *QuerySimulateAutoRestakeResponse) GetSplits() []RestakeSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *QuerySimulateAutoRestakeResponse) GetRatioSource() RatioSource {
+	if m != nil {
+		return m.RatioSource
+	}
+	return RatioSource_RATIO_SOURCE_UNSPECIFIED
+}
+
+func (m *QuerySimulateAutoRestakeResponse) GetCarryOver() types.Coin {
+	if m != nil {
+		return m.CarryOver
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateAutoRestakeResponse) GetNonBondDenoms() []NonBondDenomOutcome {
+	if m != nil {
+		return m.NonBondDenoms
+	}
+	return nil
+}
+
+// QueryCarryOversRequest is the request type for the Query/CarryOvers RPC
+// method.
+type QueryCarryOversRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCarryOversRequest) Reset()         { *m = QueryCarryOversRequest{} }
+func (m *QueryCarryOversRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCarryOversRequest) ProtoMessage()    {}
+func (*QueryCarryOversRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{23}
+}
+func (m *QueryCarryOversRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCarryOversRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCarryOversRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCarryOversRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCarryOversRequest.Merge(m, src)
+}
+func (m *QueryCarryOversRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCarryOversRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCarryOversRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCarryOversRequest proto.InternalMessageInfo
+
+func (m *QueryCarryOversRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryCarryOversRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCarryOversResponse is the response type for the Query/CarryOvers RPC
+// method.
+type QueryCarryOversResponse struct {
+	CarryOvers []CarryOver         `protobuf:"bytes,1,rep,name=carry_overs,json=carryOvers,proto3" json:"carry_overs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCarryOversResponse) Reset()         { *m = QueryCarryOversResponse{} }
+func (m *QueryCarryOversResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCarryOversResponse) ProtoMessage()    {}
+func (*QueryCarryOversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b5acc48c002eb49, []int{24}
+}
+func (m *QueryCarryOversResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCarryOversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCarryOversResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCarryOversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCarryOversResponse.Merge(m, src)
+}
+func (m *QueryCarryOversResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCarryOversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCarryOversResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCarryOversResponse proto.InternalMessageInfo
+
+func (m *QueryCarryOversResponse) GetCarryOvers() []CarryOver {
+	if m != nil {
+		return m.CarryOvers
+	}
+	return nil
+}
+
+func (m *QueryCarryOversResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorOverrideRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryValidatorOverrideRequest")
+	proto.RegisterType((*QueryValidatorOverrideResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryValidatorOverrideResponse")
+	proto.RegisterType((*QueryValidatorOverridesRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryValidatorOverridesRequest")
+	proto.RegisterType((*QueryValidatorOverridesResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryValidatorOverridesResponse")
+	proto.RegisterType((*QueryDelegatorPreferenceRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryDelegatorPreferenceRequest")
+	proto.RegisterType((*QueryDelegatorPreferenceResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryDelegatorPreferenceResponse")
+	proto.RegisterType((*QueryRestakeHistoryRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryRestakeHistoryRequest")
+	proto.RegisterType((*QueryRestakeHistoryResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryRestakeHistoryResponse")
+	proto.RegisterType((*QueryDelegatorStatsRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryDelegatorStatsRequest")
+	proto.RegisterType((*QueryDelegatorStatsResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryDelegatorStatsResponse")
+	proto.RegisterType((*QueryValidatorStatsRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryValidatorStatsRequest")
+	proto.RegisterType((*QueryValidatorStatsResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryValidatorStatsResponse")
+	proto.RegisterType((*QueryStatsRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryStatsRequest")
+	proto.RegisterType((*QueryStatsResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryStatsResponse")
+	proto.RegisterType((*QueryRetryEntriesRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryRetryEntriesRequest")
+	proto.RegisterType((*QueryRetryEntriesResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryRetryEntriesResponse")
+	proto.RegisterType((*QuerySplitPreviewRequest)(nil), "lyfeblocnetwork.restaking.v1.QuerySplitPreviewRequest")
+	proto.RegisterType((*QuerySplitPreviewResponse)(nil), "lyfeblocnetwork.restaking.v1.QuerySplitPreviewResponse")
+	proto.RegisterType((*RestakeSplit)(nil), "lyfeblocnetwork.restaking.v1.RestakeSplit")
+	proto.RegisterType((*QuerySimulateAutoRestakeRequest)(nil), "lyfeblocnetwork.restaking.v1.QuerySimulateAutoRestakeRequest")
+	proto.RegisterType((*QuerySimulateAutoRestakeResponse)(nil), "lyfeblocnetwork.restaking.v1.QuerySimulateAutoRestakeResponse")
+	proto.RegisterType((*QueryCarryOversRequest)(nil), "lyfeblocnetwork.restaking.v1.QueryCarryOversRequest")
+	proto.RegisterType((*QueryCarryOversResponse)(nil), "lyfeblocnetwork.restaking.v1.QueryCarryOversResponse")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/query.proto", fileDescriptor_2b5acc48c002eb49)
+}
+
+var fileDescriptor_2b5acc48c002eb49 = []byte{
+	// 1586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xa6, 0x8d, 0xdb, 0x4c, 0xda, 0xb4, 0x99, 0xf4, 0xfb, 0xc5, 0x75, 0x5b, 0x27, 0x2c,
+	0xa5, 0x4d, 0x7f, 0xc4, 0x5b, 0xa7, 0x14, 0xa8, 0x28, 0x88, 0xa6, 0x4e, 0x93, 0x96, 0xd0, 0xa6,
+	0x1b, 0x09, 0x24, 0x0e, 0x58, 0x9b, 0xdd, 0xa9, 0xb3, 0xc4, 0xde, 0x71, 0x77, 0xc7, 0xae, 0xac,
+	0xaa, 0x97, 0x9e, 0x39, 0x20, 0x71, 0xe0, 0x00, 0x77, 0x7e, 0x49, 0x08, 0x41, 0x0f, 0xdc, 0x10,
+	0xb7, 0x4a, 0x50, 0xa9, 0xb4, 0x17, 0x84, 0x44, 0x41, 0x2d, 0x88, 0x7f, 0x81, 0x23, 0xda, 0x99,
+	0x37, 0xeb, 0xb5, 0xbd, 0xde, 0x6c, 0x1c, 0x23, 0xe5, 0x02, 0xf1, 0xcc, 0xbc, 0xf7, 0x3e, 0x9f,
+	0x37, 0xef, 0x3d, 0xcf, 0xc7, 0x45, 0x53, 0xe5, 0xc6, 0x75, 0xb2, 0x52, 0xa6, 0xa6, 0x43, 0xd8,
+	0x4d, 0xea, 0xae, 0x69, 0x2e, 0xf1, 0x98, 0xb1, 0x66, 0x3b, 0x25, 0xad, 0x9e, 0xd7, 0x6e, 0xd4,
+	0x88, 0xdb, 0xc8, 0x55, 0x5d, 0xca, 0x28, 0x3e, 0xd8, 0x76, 0x32, 0x17, 0x9c, 0xcc, 0xd5, 0xf3,
+	0x99, 0x31, 0xa3, 0x62, 0x3b, 0x54, 0xe3, 0xff, 0x15, 0x06, 0x99, 0xe3, 0x26, 0xf5, 0x2a, 0xd4,
+	0xd3, 0x56, 0x0c, 0x8f, 0x08, 0x4f, 0x5a, 0x3d, 0xbf, 0x42, 0x98, 0x91, 0xd7, 0xaa, 0x46, 0xc9,
+	0x76, 0x0c, 0x66, 0x53, 0x07, 0xce, 0x66, 0xc3, 0x67, 0xe5, 0x29, 0x93, 0xda, 0x72, 0x7f, 0xbf,
+	0xd8, 0x2f, 0xf2, 0x4f, 0x9a, 0xf8, 0x00, 0x5b, 0xfb, 0x4a, 0xb4, 0x44, 0xc5, 0xba, 0xff, 0x17,
+	0xac, 0x1e, 0x2c, 0x51, 0x5a, 0x2a, 0x13, 0xcd, 0xa8, 0xda, 0x9a, 0xe1, 0x38, 0x94, 0xf1, 0x68,
+	0xd2, 0x66, 0x3a, 0x96, 0xb5, 0x69, 0xb8, 0x6e, 0xa3, 0x48, 0xeb, 0xc4, 0x85, 0xe3, 0x5a, 0xec,
+	0x71, 0x8b, 0x38, 0xb4, 0x52, 0xac, 0xd2, 0xb2, 0x6d, 0x36, 0x24, 0xf5, 0x58, 0x83, 0x55, 0xdb,
+	0x63, 0x54, 0xe6, 0x35, 0x73, 0x2c, 0xf6, 0x6c, 0xd5, 0x70, 0x8d, 0x4a, 0x32, 0xd8, 0x55, 0x97,
+	0x5c, 0x27, 0x2e, 0x71, 0x4c, 0x02, 0xc7, 0xe3, 0xef, 0xd6, 0x25, 0x4c, 0x62, 0x50, 0xf7, 0x21,
+	0x7c, 0xcd, 0xbf, 0xa0, 0x25, 0x1e, 0x4d, 0x27, 0x37, 0x6a, 0xc4, 0x63, 0xea, 0xfb, 0x0a, 0x1a,
+	0x6f, 0x59, 0xf6, 0xaa, 0xd4, 0xf1, 0x08, 0x3e, 0x89, 0xb0, 0x51, 0x63, 0xb4, 0x28, 0xdc, 0x91,
+	0xa2, 0xeb, 0xe7, 0x36, 0xad, 0x4c, 0x2a, 0x53, 0xc3, 0xfa, 0x5e, 0x7f, 0x47, 0x17, 0x1b, 0xba,
+	0xbf, 0x8e, 0xe7, 0x51, 0x4a, 0x90, 0x48, 0x0f, 0x4e, 0x2a, 0x53, 0x23, 0x33, 0x87, 0x73, 0x71,
+	0x85, 0x94, 0x13, 0xb1, 0x66, 0x87, 0xef, 0x3d, 0x9e, 0x18, 0xf8, 0xec, 0xef, 0xaf, 0x8f, 0x2b,
+	0x3a, 0x98, 0xab, 0x14, 0x1d, 0xe2, 0x68, 0xde, 0x32, 0xca, 0xb6, 0x65, 0x30, 0xea, 0x5e, 0xad,
+	0x13, 0xd7, 0xb5, 0x2d, 0x02, 0x78, 0xf1, 0x15, 0x34, 0x56, 0x97, 0x7b, 0x45, 0xc3, 0xb2, 0x5c,
+	0xe2, 0x79, 0x02, 0xd6, 0xec, 0xb3, 0x0f, 0xef, 0x4e, 0x1f, 0x82, 0xb2, 0x09, 0xec, 0xcf, 0x8b,
+	0x23, 0xcb, 0xcc, 0xb5, 0x9d, 0x92, 0xbe, 0xb7, 0xde, 0xb6, 0xae, 0x7a, 0x28, 0xdb, 0x2d, 0x20,
+	0x64, 0xe2, 0x1a, 0xda, 0x49, 0x61, 0x8d, 0x07, 0x1a, 0x99, 0xd1, 0xe2, 0xd9, 0x75, 0xb8, 0x9a,
+	0xdd, 0xee, 0x13, 0xd5, 0x03, 0x37, 0xea, 0x6a, 0xb7, 0xa0, 0xf2, 0x5a, 0xf0, 0x45, 0x84, 0x9a,
+	0xfd, 0x03, 0x61, 0x8f, 0xe4, 0x80, 0x9c, 0xdf, 0x40, 0x39, 0xd1, 0xb6, 0xd0, 0x46, 0xb9, 0x25,
+	0xa3, 0x24, 0x53, 0xa4, 0x87, 0x2c, 0xd5, 0xef, 0x15, 0x34, 0xd1, 0x35, 0x14, 0x10, 0x5c, 0x46,
+	0xc3, 0x12, 0x99, 0x9f, 0xca, 0x6d, 0xbd, 0x33, 0x6c, 0xfa, 0xc1, 0xf3, 0x2d, 0x04, 0x44, 0x55,
+	0x1c, 0x5d, 0x97, 0x80, 0x40, 0xd4, 0xc2, 0x60, 0x15, 0x08, 0x14, 0x48, 0x99, 0x94, 0xfc, 0x98,
+	0x4b, 0x41, 0x0b, 0xc8, 0x64, 0xcd, 0xa1, 0x31, 0x4b, 0xee, 0xb6, 0xd5, 0x44, 0xfa, 0xe1, 0xdd,
+	0xe9, 0x7d, 0x10, 0xb5, 0xad, 0x14, 0x02, 0x13, 0x59, 0x0a, 0xb7, 0xd0, 0x64, 0xf7, 0x48, 0x90,
+	0xab, 0xb7, 0x11, 0x6a, 0xb6, 0x20, 0xdc, 0x4b, 0x3e, 0x3e, 0x59, 0x11, 0xee, 0x20, 0x5d, 0x21,
+	0x57, 0xea, 0x97, 0x0a, 0xca, 0xf0, 0xe8, 0xd0, 0x57, 0x0b, 0x62, 0x7e, 0xf4, 0x97, 0x62, 0x5b,
+	0x59, 0x0d, 0xf6, 0x5c, 0x56, 0xdf, 0x2a, 0xe8, 0x40, 0x24, 0x5a, 0x48, 0xd3, 0x1b, 0x68, 0x87,
+	0x4b, 0x4c, 0xea, 0x5a, 0xb2, 0xa0, 0x4e, 0xc4, 0xe7, 0x48, 0x0e, 0x13, 0x6e, 0x03, 0xd9, 0x91,
+	0x1e, 0xfa, 0x57, 0x4a, 0x26, 0xa4, 0x38, 0xb8, 0x91, 0x65, 0x66, 0x30, 0xaf, 0xcf, 0x55, 0x54,
+	0x84, 0xcc, 0xb4, 0x07, 0x81, 0xcc, 0xbc, 0x8e, 0x76, 0xc2, 0x48, 0xb5, 0xa0, 0x7c, 0xf6, 0xb7,
+	0x50, 0x91, 0x24, 0x2e, 0x50, 0xdb, 0x09, 0x0f, 0xc8, 0xc0, 0x4a, 0x2d, 0x03, 0x8b, 0xa0, 0x09,
+	0x5b, 0x58, 0xf4, 0x7b, 0x3e, 0x4a, 0x3a, 0xed, 0xd1, 0xfa, 0x46, 0x67, 0x1c, 0x8d, 0xf1, 0x00,
+	0x61, 0x16, 0xfe, 0xb7, 0x12, 0x0e, 0xaf, 0x06, 0x65, 0x35, 0xca, 0x28, 0x33, 0xca, 0xc5, 0x9e,
+	0x62, 0xee, 0xe6, 0xb6, 0x50, 0x6a, 0x16, 0x3e, 0x86, 0xf6, 0x1a, 0x26, 0xb3, 0xeb, 0x44, 0x7a,
+	0x73, 0xc5, 0xb7, 0xd7, 0x76, 0x7d, 0x8f, 0x58, 0xd7, 0xe5, 0xb2, 0xfa, 0xb9, 0x82, 0xd2, 0x50,
+	0xee, 0xcc, 0x6d, 0xcc, 0x39, 0xcc, 0xb5, 0x89, 0xb7, 0x45, 0x5b, 0xf3, 0x2b, 0x05, 0xed, 0x8f,
+	0xc0, 0x0a, 0x19, 0x5c, 0x40, 0x3b, 0x88, 0x58, 0x82, 0xc6, 0x9c, 0x5a, 0xaf, 0x31, 0xc1, 0x49,
+	0x43, 0x76, 0x25, 0x98, 0xf7, 0xaf, 0x2b, 0xff, 0x91, 0xc9, 0x5d, 0xae, 0x96, 0x6d, 0xb6, 0xe4,
+	0x92, 0xba, 0x4d, 0x6e, 0xf6, 0x39, 0xb9, 0x91, 0x5d, 0x31, 0xd8, 0x73, 0x57, 0xe0, 0x39, 0x7f,
+	0xbe, 0xdd, 0x34, 0xfc, 0xf9, 0xb6, 0x8d, 0x7b, 0x39, 0xe1, 0x27, 0xe7, 0xd7, 0xc7, 0x13, 0xff,
+	0x13, 0x9e, 0x3c, 0x6b, 0x2d, 0x67, 0x53, 0xad, 0x62, 0xb0, 0xd5, 0xdc, 0x25, 0x87, 0x3d, 0xbc,
+	0x3b, 0x8d, 0x20, 0xc4, 0x25, 0x87, 0xe9, 0xd2, 0x56, 0xfd, 0x54, 0xde, 0x55, 0x2b, 0xf5, 0x7e,
+	0xf5, 0x16, 0x5e, 0x40, 0x29, 0xcf, 0xf7, 0xec, 0x73, 0xf5, 0x2f, 0xfb, 0x78, 0xa2, 0x29, 0xcc,
+	0xc1, 0xc0, 0x75, 0x83, 0xbd, 0xfa, 0x89, 0x82, 0x76, 0x85, 0xb7, 0xfb, 0x3d, 0x67, 0xf0, 0x39,
+	0x94, 0x32, 0x2a, 0xb4, 0xe6, 0x30, 0x28, 0xa5, 0x64, 0x54, 0xc1, 0x46, 0xfd, 0x4e, 0x3e, 0x73,
+	0x96, 0xed, 0x4a, 0xad, 0x6c, 0x30, 0x72, 0x3e, 0xf4, 0x42, 0xdd, 0xd2, 0xa5, 0xa4, 0xfe, 0x30,
+	0x04, 0xcf, 0x8e, 0x48, 0xe8, 0x50, 0x0a, 0xef, 0x35, 0xeb, 0x4d, 0xb4, 0x6d, 0x4c, 0x7a, 0xce,
+	0xf8, 0xe9, 0xf9, 0xe2, 0xf7, 0x89, 0xa9, 0x92, 0xcd, 0x56, 0x6b, 0x2b, 0x39, 0x93, 0x56, 0x40,
+	0x4c, 0xc1, 0xff, 0xa6, 0x3d, 0x6b, 0x4d, 0x63, 0x8d, 0x2a, 0xf1, 0xb8, 0x81, 0x27, 0x52, 0x29,
+	0x03, 0xb4, 0x94, 0xdd, 0x60, 0x4f, 0x65, 0xb7, 0x8a, 0x52, 0x65, 0xfb, 0x46, 0xcd, 0xb6, 0xd2,
+	0xdb, 0xfe, 0x23, 0xb0, 0xe0, 0x3f, 0x54, 0xe0, 0xdb, 0x37, 0x57, 0xe0, 0x78, 0x1e, 0x0d, 0x09,
+	0x89, 0x33, 0xc4, 0xaf, 0x32, 0x0f, 0xfd, 0x7c, 0xa0, 0xb3, 0x9f, 0x17, 0x49, 0xc9, 0x30, 0x1b,
+	0x05, 0x62, 0x86, 0xba, 0xba, 0x40, 0x4c, 0x5d, 0xd8, 0xe3, 0x45, 0xb4, 0x8b, 0xff, 0x51, 0xf4,
+	0x68, 0xcd, 0x35, 0x49, 0x3a, 0x35, 0xa9, 0x4c, 0x8d, 0xce, 0x1c, 0x5b, 0x07, 0x98, 0x6f, 0xb1,
+	0xcc, 0x0d, 0xf4, 0x11, 0xb7, 0xf9, 0x01, 0x5f, 0x40, 0xa8, 0xa9, 0x54, 0xd3, 0x3b, 0x36, 0x70,
+	0x1d, 0xc3, 0xdc, 0xce, 0x7f, 0xa0, 0xe3, 0x22, 0xda, 0xe3, 0x50, 0xa7, 0xb8, 0x42, 0x1d, 0xab,
+	0xc8, 0x85, 0xac, 0x97, 0xde, 0xc9, 0xd3, 0xb5, 0xce, 0xcb, 0xf5, 0x0a, 0x75, 0x66, 0xa9, 0x63,
+	0x15, 0x7c, 0x93, 0xab, 0x35, 0x66, 0xd2, 0x8a, 0x7c, 0xb9, 0xee, 0x76, 0x42, 0x5b, 0x7c, 0x8e,
+	0xfd, 0x9f, 0xd7, 0xf0, 0x05, 0x19, 0x73, 0xab, 0x7e, 0x3b, 0x7e, 0xa3, 0xa0, 0x67, 0x3a, 0x90,
+	0x42, 0x93, 0x5d, 0x41, 0x23, 0xcd, 0x5c, 0xcb, 0x46, 0x3b, 0x1a, 0x9f, 0xa2, 0xc0, 0x8d, 0x7c,
+	0xd2, 0x07, 0x59, 0xef, 0xdf, 0x37, 0xe4, 0xcc, 0xfd, 0x71, 0x34, 0xc4, 0x41, 0xe3, 0x8f, 0x15,
+	0x94, 0x12, 0xe2, 0x19, 0x9f, 0x8a, 0x07, 0xd6, 0x29, 0xf5, 0x33, 0xf9, 0x0d, 0x58, 0x08, 0x14,
+	0xea, 0xc9, 0x3b, 0x8f, 0xfe, 0xfc, 0x70, 0xf0, 0x08, 0x3e, 0xac, 0x25, 0xf8, 0x01, 0x03, 0xff,
+	0xa6, 0xa0, 0xb1, 0x0e, 0x69, 0x88, 0x5f, 0x49, 0x10, 0xb6, 0x9b, 0xdc, 0xcf, 0x9c, 0xeb, 0xcd,
+	0x18, 0xe0, 0xbf, 0xc9, 0xe1, 0xcf, 0xe3, 0xb9, 0x78, 0xf8, 0xcd, 0x79, 0x1e, 0xe8, 0x57, 0xed,
+	0x56, 0xc7, 0x90, 0xbf, 0x8d, 0x7f, 0x52, 0x10, 0xee, 0xd4, 0xd1, 0xb8, 0x27, 0x8c, 0xc1, 0xad,
+	0xbc, 0xda, 0xa3, 0x35, 0x50, 0x3c, 0xcb, 0x29, 0x9e, 0xc6, 0xf9, 0x0d, 0x53, 0xc4, 0x7f, 0x29,
+	0x68, 0x3c, 0x42, 0x9c, 0xe2, 0x24, 0x88, 0xba, 0xab, 0xf1, 0xcc, 0x6b, 0xbd, 0x9a, 0x03, 0xa3,
+	0xab, 0x9c, 0xd1, 0x25, 0x3c, 0xbf, 0xde, 0x2f, 0x72, 0xe0, 0xc2, 0xd3, 0x6e, 0x75, 0x4c, 0x98,
+	0xdb, 0xa1, 0x1f, 0xca, 0xf0, 0x23, 0x05, 0x8d, 0xb6, 0xea, 0x54, 0xfc, 0x72, 0x02, 0x8c, 0x91,
+	0x42, 0x3c, 0x73, 0xb6, 0x07, 0x4b, 0x20, 0xb6, 0xc8, 0x89, 0x5d, 0xc4, 0x85, 0x4d, 0x11, 0x83,
+	0x1f, 0x16, 0xf1, 0xcf, 0x0a, 0x1a, 0x6d, 0xd5, 0x98, 0x89, 0x58, 0x45, 0x6a, 0xdf, 0x44, 0xac,
+	0xa2, 0x05, 0xad, 0x7a, 0x99, 0xb3, 0x2a, 0xe0, 0xd9, 0x4d, 0xb1, 0xf2, 0x38, 0x01, 0x9f, 0x53,
+	0xab, 0xd0, 0x4c, 0xc4, 0x29, 0x52, 0x09, 0x27, 0xe2, 0x14, 0xad, 0x6a, 0x93, 0x72, 0x0a, 0x9a,
+	0x2a, 0x72, 0x5c, 0x00, 0xa7, 0x8f, 0x14, 0x34, 0x24, 0xa8, 0x68, 0x09, 0x00, 0xb5, 0x30, 0x38,
+	0x95, 0xdc, 0x00, 0x80, 0x9f, 0xe0, 0xc0, 0x9f, 0xc7, 0xcf, 0xc5, 0x03, 0x17, 0xc8, 0xee, 0xf3,
+	0x37, 0x7d, 0x53, 0x24, 0xe2, 0x17, 0x13, 0xd5, 0x76, 0x87, 0x02, 0xce, 0xbc, 0xb4, 0x61, 0xbb,
+	0xbe, 0x76, 0x84, 0x4b, 0x04, 0xfc, 0x47, 0x0a, 0xda, 0x15, 0x16, 0x52, 0x89, 0xf8, 0x44, 0x88,
+	0xce, 0x44, 0x7c, 0xa2, 0x14, 0x9b, 0xaa, 0x73, 0x3e, 0x8b, 0xf8, 0xf2, 0xe6, 0x7a, 0xc1, 0x77,
+	0x5d, 0xac, 0x02, 0x89, 0x3b, 0x83, 0x68, 0x3c, 0x42, 0x1a, 0x24, 0x9a, 0xd2, 0xdd, 0xd5, 0x50,
+	0xa2, 0x29, 0x1d, 0xa3, 0x48, 0xd4, 0x32, 0xa7, 0x7a, 0x1d, 0x5b, 0x9b, 0xa2, 0xba, 0x5e, 0x07,
+	0x01, 0x00, 0xfc, 0xa3, 0x82, 0x50, 0xf3, 0xc5, 0x86, 0x5f, 0x48, 0x00, 0xbe, 0xe3, 0x29, 0x9a,
+	0x39, 0xb3, 0x41, 0x2b, 0x60, 0xba, 0xc4, 0x99, 0x5e, 0xc6, 0x0b, 0x9b, 0x62, 0x1a, 0x7a, 0x59,
+	0xce, 0xbe, 0x7b, 0xef, 0x49, 0x56, 0x79, 0xf0, 0x24, 0xab, 0xfc, 0xf1, 0x24, 0xab, 0x7c, 0xf0,
+	0x34, 0x3b, 0xf0, 0xe0, 0x69, 0x76, 0xe0, 0x97, 0xa7, 0xd9, 0x81, 0x77, 0x0a, 0x21, 0x19, 0xe4,
+	0x47, 0x2b, 0x53, 0x5a, 0xb5, 0x1d, 0x33, 0x88, 0x3c, 0x2d, 0x43, 0xc7, 0x41, 0x59, 0x49, 0xf1,
+	0x7f, 0xf0, 0x39, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x07, 0x28, 0x60, 0xf8, 0x1b,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorOverride returns the auto-restake ratio override of a validator.
+	ValidatorOverride(ctx context.Context, in *QueryValidatorOverrideRequest, opts ...grpc.CallOption) (*QueryValidatorOverrideResponse, error)
+	// ValidatorOverrides lists all validator auto-restake ratio overrides.
+	ValidatorOverrides(ctx context.Context, in *QueryValidatorOverridesRequest, opts ...grpc.CallOption) (*QueryValidatorOverridesResponse, error)
+	// DelegatorPreference returns the auto-restake preference of a delegator.
+	DelegatorPreference(ctx context.Context, in *QueryDelegatorPreferenceRequest, opts ...grpc.CallOption) (*QueryDelegatorPreferenceResponse, error)
+	// RestakeHistory returns the most recent auto-restakes of a delegator,
+	// oldest first.
+	RestakeHistory(ctx context.Context, in *QueryRestakeHistoryRequest, opts ...grpc.CallOption) (*QueryRestakeHistoryResponse, error)
+	// DelegatorStats returns the cumulative amount auto-restaked for a delegator.
+	DelegatorStats(ctx context.Context, in *QueryDelegatorStatsRequest, opts ...grpc.CallOption) (*QueryDelegatorStatsResponse, error)
+	// ValidatorStats returns the cumulative amount auto-restaked to a validator.
+	ValidatorStats(ctx context.Context, in *QueryValidatorStatsRequest, opts ...grpc.CallOption) (*QueryValidatorStatsResponse, error)
+	// Stats returns the chain-wide auto-restake totals.
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// RetryEntries returns the pending and failed auto-restake retries of a
+	// delegator.
+	RetryEntries(ctx context.Context, in *QueryRetryEntriesRequest, opts ...grpc.CallOption) (*QueryRetryEntriesResponse, error)
+	// SplitPreview previews how a reward amount paid by a validator would be
+	// restaked for the delegator, including the diversification split.
+	SplitPreview(ctx context.Context, in *QuerySplitPreviewRequest, opts ...grpc.CallOption) (*QuerySplitPreviewResponse, error)
+	// SimulateAutoRestake previews what withdrawing the delegator's pending
+	// rewards from a validator would auto-restake, without changing any state.
+	SimulateAutoRestake(ctx context.Context, in *QuerySimulateAutoRestakeRequest, opts ...grpc.CallOption) (*QuerySimulateAutoRestakeResponse, error)
+	// CarryOvers returns the amounts held back from a delegator's auto-restakes
+	// below min_restake_amount, per validator paying the rewards.
+	CarryOvers(ctx context.Context, in *QueryCarryOversRequest, opts ...grpc.CallOption) (*QueryCarryOversResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOverride(ctx context.Context, in *QueryValidatorOverrideRequest, opts ...grpc.CallOption) (*QueryValidatorOverrideResponse, error) {
+	out := new(QueryValidatorOverrideResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/ValidatorOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOverrides(ctx context.Context, in *QueryValidatorOverridesRequest, opts ...grpc.CallOption) (*QueryValidatorOverridesResponse, error) {
+	out := new(QueryValidatorOverridesResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/ValidatorOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorPreference(ctx context.Context, in *QueryDelegatorPreferenceRequest, opts ...grpc.CallOption) (*QueryDelegatorPreferenceResponse, error) {
+	out := new(QueryDelegatorPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/DelegatorPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RestakeHistory(ctx context.Context, in *QueryRestakeHistoryRequest, opts ...grpc.CallOption) (*QueryRestakeHistoryResponse, error) {
+	out := new(QueryRestakeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/RestakeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorStats(ctx context.Context, in *QueryDelegatorStatsRequest, opts ...grpc.CallOption) (*QueryDelegatorStatsResponse, error) {
+	out := new(QueryDelegatorStatsResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/DelegatorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorStats(ctx context.Context, in *QueryValidatorStatsRequest, opts ...grpc.CallOption) (*QueryValidatorStatsResponse, error) {
+	out := new(QueryValidatorStatsResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/ValidatorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetryEntries(ctx context.Context, in *QueryRetryEntriesRequest, opts ...grpc.CallOption) (*QueryRetryEntriesResponse, error) {
+	out := new(QueryRetryEntriesResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/RetryEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SplitPreview(ctx context.Context, in *QuerySplitPreviewRequest, opts ...grpc.CallOption) (*QuerySplitPreviewResponse, error) {
+	out := new(QuerySplitPreviewResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/SplitPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAutoRestake(ctx context.Context, in *QuerySimulateAutoRestakeRequest, opts ...grpc.CallOption) (*QuerySimulateAutoRestakeResponse, error) {
+	out := new(QuerySimulateAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/SimulateAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CarryOvers(ctx context.Context, in *QueryCarryOversRequest, opts ...grpc.CallOption) (*QueryCarryOversResponse, error) {
+	out := new(QueryCarryOversResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Query/CarryOvers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorOverride returns the auto-restake ratio override of a validator.
+	ValidatorOverride(context.Context, *QueryValidatorOverrideRequest) (*QueryValidatorOverrideResponse, error)
+	// ValidatorOverrides lists all validator auto-restake ratio overrides.
+	ValidatorOverrides(context.Context, *QueryValidatorOverridesRequest) (*QueryValidatorOverridesResponse, error)
+	// DelegatorPreference returns the auto-restake preference of a delegator.
+	DelegatorPreference(context.Context, *QueryDelegatorPreferenceRequest) (*QueryDelegatorPreferenceResponse, error)
+	// RestakeHistory returns the most recent auto-restakes of a delegator,
+	// oldest first.
+	RestakeHistory(context.Context, *QueryRestakeHistoryRequest) (*QueryRestakeHistoryResponse, error)
+	// DelegatorStats returns the cumulative amount auto-restaked for a delegator.
+	DelegatorStats(context.Context, *QueryDelegatorStatsRequest) (*QueryDelegatorStatsResponse, error)
+	// ValidatorStats returns the cumulative amount auto-restaked to a validator.
+	ValidatorStats(context.Context, *QueryValidatorStatsRequest) (*QueryValidatorStatsResponse, error)
+	// Stats returns the chain-wide auto-restake totals.
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// RetryEntries returns the pending and failed auto-restake retries of a
+	// delegator.
+	RetryEntries(context.Context, *QueryRetryEntriesRequest) (*QueryRetryEntriesResponse, error)
+	// SplitPreview previews how a reward amount paid by a validator would be
+	// restaked for the delegator, including the diversification split.
+	SplitPreview(context.Context, *QuerySplitPreviewRequest) (*QuerySplitPreviewResponse, error)
+	// SimulateAutoRestake previews what withdrawing the delegator's pending
+	// rewards from a validator would auto-restake, without changing any state.
+	SimulateAutoRestake(context.Context, *QuerySimulateAutoRestakeRequest) (*QuerySimulateAutoRestakeResponse, error)
+	// CarryOvers returns the amounts held back from a delegator's auto-restakes
+	// below min_restake_amount, per validator paying the rewards.
+	CarryOvers(context.Context, *QueryCarryOversRequest) (*QueryCarryOversResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOverride(ctx context.Context, req *QueryValidatorOverrideRequest) (*QueryValidatorOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOverride not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOverrides(ctx context.Context, req *QueryValidatorOverridesRequest) (*QueryValidatorOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOverrides not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPreference(ctx context.Context, req *QueryDelegatorPreferenceRequest) (*QueryDelegatorPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPreference not implemented")
+}
+func (*UnimplementedQueryServer) RestakeHistory(ctx context.Context, req *QueryRestakeHistoryRequest) (*QueryRestakeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestakeHistory not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorStats(ctx context.Context, req *QueryDelegatorStatsRequest) (*QueryDelegatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorStats not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorStats(ctx context.Context, req *QueryValidatorStatsRequest) (*QueryValidatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStats not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) RetryEntries(ctx context.Context, req *QueryRetryEntriesRequest) (*QueryRetryEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryEntries not implemented")
+}
+func (*UnimplementedQueryServer) SplitPreview(ctx context.Context, req *QuerySplitPreviewRequest) (*QuerySplitPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitPreview not implemented")
+}
+func (*UnimplementedQueryServer) SimulateAutoRestake(ctx context.Context, req *QuerySimulateAutoRestakeRequest) (*QuerySimulateAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAutoRestake not implemented")
+}
+func (*UnimplementedQueryServer) CarryOvers(ctx context.Context, req *QueryCarryOversRequest) (*QueryCarryOversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CarryOvers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/ValidatorOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOverride(ctx, req.(*QueryValidatorOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/ValidatorOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOverrides(ctx, req.(*QueryValidatorOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/DelegatorPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPreference(ctx, req.(*QueryDelegatorPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RestakeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestakeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RestakeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/RestakeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestakeHistory(ctx, req.(*QueryRestakeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/DelegatorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorStats(ctx, req.(*QueryDelegatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/ValidatorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorStats(ctx, req.(*QueryValidatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetryEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetryEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetryEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/RetryEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetryEntries(ctx, req.(*QueryRetryEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SplitPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySplitPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SplitPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/SplitPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SplitPreview(ctx, req.(*QuerySplitPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAutoRestakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/SimulateAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAutoRestake(ctx, req.(*QuerySimulateAutoRestakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CarryOvers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCarryOversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CarryOvers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Query/CarryOvers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CarryOvers(ctx, req.(*QueryCarryOversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lyfeblocnetwork.restaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorOverride",
+			Handler:    _Query_ValidatorOverride_Handler,
+		},
+		{
+			MethodName: "ValidatorOverrides",
+			Handler:    _Query_ValidatorOverrides_Handler,
+		},
+		{
+			MethodName: "DelegatorPreference",
+			Handler:    _Query_DelegatorPreference_Handler,
+		},
+		{
+			MethodName: "RestakeHistory",
+			Handler:    _Query_RestakeHistory_Handler,
+		},
+		{
+			MethodName: "DelegatorStats",
+			Handler:    _Query_DelegatorStats_Handler,
+		},
+		{
+			MethodName: "ValidatorStats",
+			Handler:    _Query_ValidatorStats_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "RetryEntries",
+			Handler:    _Query_RetryEntries_Handler,
+		},
+		{
+			MethodName: "SplitPreview",
+			Handler:    _Query_SplitPreview_Handler,
+		},
+		{
+			MethodName: "SimulateAutoRestake",
+			Handler:    _Query_SimulateAutoRestake_Handler,
+		},
+		{
+			MethodName: "CarryOvers",
+			Handler:    _Query_CarryOvers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lyfeblocnetwork/restaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AutoRestakeRatio) > 0 {
+		i -= len(m.AutoRestakeRatio)
+		copy(dAtA[i:], m.AutoRestakeRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoRestakeRatio)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOverrideRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOverrideRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOverrideRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Override.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPreferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPreferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPreferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Preference.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRestakeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRestakeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRestakeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRestakeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRestakeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRestakeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Restaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Restaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveRestakers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveRestakers))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TotalRestaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySplitPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySplitPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySplitPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rewards.Size()
+		i -= size
+		if _, err := m.Rewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySplitPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySplitPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySplitPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Restaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RestakeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestakeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestakeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAutoRestakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAutoRestakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAutoRestakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonBondDenoms) > 0 {
+		for iNdEx := len(m.NonBondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonBondDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.CarryOver.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.RatioSource != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RatioSource))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Liquid) > 0 {
+		for iNdEx := len(m.Liquid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Restaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCarryOversRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCarryOversRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCarryOversRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCarryOversResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCarryOversResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCarryOversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CarryOvers) > 0 {
+		for iNdEx := len(m.CarryOvers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CarryOvers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AutoRestakeRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOverrideRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Override.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPreferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Preference.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRestakeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRestakeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Restaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Restaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalRestaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ActiveRestakers != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveRestakers))
+	}
+	return n
+}
+
+func (m *QueryRetryEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetryEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySplitPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySplitPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Restaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RestakeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateAutoRestakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Restaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Liquid) > 0 {
+		for _, e := range m.Liquid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RatioSource != 0 {
+		n += 1 + sovQuery(uint64(m.RatioSource))
+	}
+	l = m.CarryOver.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.NonBondDenoms) > 0 {
+		for _, e := range m.NonBondDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCarryOversRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCarryOversResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CarryOvers) > 0 {
+		for _, e := range m.CarryOvers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakeRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOverrideRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOverrideRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOverrideRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Override.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOverridesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOverridesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, ValidatorOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPreferenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPreferenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPreferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Preference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, RestakeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRestaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRestaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRestakers", wireType)
+			}
+			m.ActiveRestakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveRestakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RetryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySplitPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySplitPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySplitPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySplitPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySplitPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySplitPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, RestakeSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestakeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestakeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestakeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateAutoRestakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateAutoRestakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateAutoRestakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateAutoRestakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateAutoRestakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquid = append(m.Liquid, types.Coin{})
+			if err := m.Liquid[len(m.Liquid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, RestakeSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioSource", wireType)
+			}
+			m.RatioSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatioSource |= RatioSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryOver", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarryOver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonBondDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonBondDenoms = append(m.NonBondDenoms, NonBondDenomOutcome{})
+			if err := m.NonBondDenoms[len(m.NonBondDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AutoRestakeRatio)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCarryOversRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -335,12 +5898,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCarryOversRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCarryOversRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -362,7 +5993,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCarryOversResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -385,17 +6016,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCarryOversResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCarryOversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryOvers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -405,23 +6036,61 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoRestakeRatio = string(dAtA[iNdEx:postIndex])
+			m.CarryOvers = append(m.CarryOvers, CarryOver{})
+			if err := m.CarryOvers[len(m.CarryOvers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
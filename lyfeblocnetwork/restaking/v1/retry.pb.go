@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/retry.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RetryStatus is the state of a queued auto-restake retry.
+type RetryStatus int32
+
+const (
+	// RETRY_STATUS_UNSPECIFIED is never assigned to a stored entry.
+	RetryStatus_RETRY_STATUS_UNSPECIFIED RetryStatus = 0
+	// RETRY_STATUS_PENDING entries are retried once next_attempt_height is reached.
+	RetryStatus_RETRY_STATUS_PENDING RetryStatus = 1
+	// RETRY_STATUS_FAILED entries used up their attempts. They are kept until
+	// the delegator cancels or force-retries them, or until prune_height.
+	RetryStatus_RETRY_STATUS_FAILED RetryStatus = 2
+)
+
+var RetryStatus_name = map[int32]string{
+	0: "RETRY_STATUS_UNSPECIFIED",
+	1: "RETRY_STATUS_PENDING",
+	2: "RETRY_STATUS_FAILED",
+}
+
+var RetryStatus_value = map[string]int32{
+	"RETRY_STATUS_UNSPECIFIED": 0,
+	"RETRY_STATUS_PENDING":     1,
+	"RETRY_STATUS_FAILED":      2,
+}
+
+func (x RetryStatus) String() string {
+	return proto.EnumName(RetryStatus_name, int32(x))
+}
+
+func (RetryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fdc74ef80cead292, []int{0}
+}
+
+// RetryEntry is an auto-restake whose delegation failed and is retried with
+// exponential backoff.
+type RetryEntry struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// restake is the auto-restake being retried. Its height is the height of
+	// the original attempt.
+	Restake RestakeRecord `protobuf:"bytes,2,opt,name=restake,proto3" json:"restake"`
+	Status  RetryStatus   `protobuf:"varint,3,opt,name=status,proto3,enum=lyfeblocnetwork.restaking.v1.RetryStatus" json:"status,omitempty"`
+	// attempts is the number of retries made so far.
+	Attempts uint32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_attempt_height is the height at which a pending entry is retried.
+	NextAttemptHeight int64 `protobuf:"varint,5,opt,name=next_attempt_height,json=nextAttemptHeight,proto3" json:"next_attempt_height,omitempty"`
+	// last_error is the error of the most recent attempt.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// prune_height is the height at which a failed entry is dropped.
+	PruneHeight int64 `protobuf:"varint,7,opt,name=prune_height,json=pruneHeight,proto3" json:"prune_height,omitempty"`
+}
+
+func (m *RetryEntry) Reset()         { *m = RetryEntry{} }
+func (m *RetryEntry) String() string { return proto.CompactTextString(m) }
+func (*RetryEntry) ProtoMessage()    {}
+func (*RetryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc74ef80cead292, []int{0}
+}
+func (m *RetryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryEntry.Merge(m, src)
+}
+func (m *RetryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryEntry proto.InternalMessageInfo
+
+func (m *RetryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RetryEntry) GetRestake() RestakeRecord {
+	if m != nil {
+		return m.Restake
+	}
+	return RestakeRecord{}
+}
+
+func (m *RetryEntry) GetStatus() RetryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RetryStatus_RETRY_STATUS_UNSPECIFIED
+}
+
+func (m *RetryEntry) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *RetryEntry) GetNextAttemptHeight() int64 {
+	if m != nil {
+		return m.NextAttemptHeight
+	}
+	return 0
+}
+
+func (m *RetryEntry) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *RetryEntry) GetPruneHeight() int64 {
+	if m != nil {
+		return m.PruneHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("lyfeblocnetwork.restaking.v1.RetryStatus", RetryStatus_name, RetryStatus_value)
+	proto.RegisterType((*RetryEntry)(nil), "lyfeblocnetwork.restaking.v1.RetryEntry")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/retry.proto", fileDescriptor_fdc74ef80cead292)
+}
+
+var fileDescriptor_fdc74ef80cead292 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0x94, 0x50,
+	0x14, 0xc7, 0xb9, 0x74, 0x9c, 0xda, 0x3b, 0xda, 0x8c, 0xb7, 0x4d, 0xbc, 0x99, 0x54, 0x44, 0x57,
+	0x58, 0x23, 0xa4, 0xf5, 0x09, 0xa8, 0x50, 0x25, 0x9a, 0x49, 0x73, 0x99, 0x2e, 0x74, 0x51, 0xc2,
+	0x30, 0x57, 0x20, 0xa5, 0x5c, 0x72, 0x39, 0x53, 0xe5, 0x2d, 0x7c, 0x1e, 0x9f, 0xa0, 0xcb, 0x2e,
+	0x5d, 0x19, 0x33, 0xf3, 0x22, 0x06, 0x28, 0x13, 0xc7, 0xc5, 0x74, 0x07, 0xff, 0x8f, 0x1f, 0x27,
+	0x87, 0x83, 0x8d, 0xac, 0xfa, 0xca, 0xa7, 0x99, 0x88, 0x72, 0x0e, 0xdf, 0x84, 0xbc, 0xb4, 0x24,
+	0x2f, 0x21, 0xbc, 0x4c, 0xf3, 0xd8, 0xba, 0x3e, 0xb2, 0x24, 0x07, 0x59, 0x99, 0x85, 0x14, 0x20,
+	0xc8, 0xc1, 0x7f, 0x49, 0x73, 0x95, 0x34, 0xaf, 0x8f, 0x46, 0xfb, 0xb1, 0x88, 0x45, 0x13, 0xb4,
+	0xea, 0xa7, 0xb6, 0x33, 0x3a, 0xdc, 0x48, 0x4f, 0xd2, 0x12, 0x44, 0xc7, 0x7f, 0xf9, 0x53, 0xc5,
+	0x98, 0xd5, 0xdf, 0x73, 0x73, 0x90, 0x15, 0xd9, 0xc5, 0x6a, 0x3a, 0xa3, 0x48, 0x47, 0x46, 0x8f,
+	0xa9, 0xe9, 0x8c, 0x7c, 0xc4, 0xdb, 0x6d, 0x99, 0x53, 0x55, 0x47, 0xc6, 0xe0, 0xf8, 0xb5, 0xb9,
+	0x69, 0x20, 0x93, 0xb5, 0x61, 0xc6, 0x23, 0x21, 0x67, 0x27, 0xbd, 0x9b, 0xdf, 0xcf, 0x15, 0xd6,
+	0x11, 0x88, 0x8d, 0xfb, 0x25, 0x84, 0x30, 0x2f, 0xe9, 0x96, 0x8e, 0x8c, 0xdd, 0xe3, 0x57, 0xf7,
+	0xb1, 0x40, 0x56, 0x7e, 0x53, 0x60, 0x77, 0x45, 0x32, 0xc2, 0x0f, 0x43, 0x00, 0x7e, 0x55, 0x40,
+	0x49, 0x7b, 0x3a, 0x32, 0x1e, 0xb3, 0xd5, 0x3b, 0x31, 0xf1, 0x5e, 0xce, 0xbf, 0x43, 0x70, 0x27,
+	0x04, 0x09, 0x4f, 0xe3, 0x04, 0xe8, 0x03, 0x1d, 0x19, 0x5b, 0xec, 0x49, 0x6d, 0xd9, 0xad, 0xf3,
+	0xa1, 0x31, 0xc8, 0x33, 0x8c, 0xb3, 0xb0, 0x84, 0x80, 0x4b, 0x29, 0x24, 0xed, 0xeb, 0xc8, 0xd8,
+	0x61, 0x3b, 0xb5, 0xe2, 0xd6, 0x02, 0x79, 0x81, 0x1f, 0x15, 0x72, 0x9e, 0xf3, 0x8e, 0xb3, 0xdd,
+	0x70, 0x06, 0x8d, 0xd6, 0x12, 0x0e, 0x2f, 0xf0, 0xe0, 0x9f, 0x21, 0xc9, 0x01, 0xa6, 0xcc, 0x9d,
+	0xb0, 0xcf, 0x81, 0x3f, 0xb1, 0x27, 0xe7, 0x7e, 0x70, 0x3e, 0xf6, 0xcf, 0xdc, 0x77, 0xde, 0xa9,
+	0xe7, 0x3a, 0x43, 0x85, 0x50, 0xbc, 0xbf, 0xe6, 0x9e, 0xb9, 0x63, 0xc7, 0x1b, 0xbf, 0x1f, 0x22,
+	0xf2, 0x14, 0xef, 0xad, 0x39, 0xa7, 0xb6, 0xf7, 0xc9, 0x75, 0x86, 0xea, 0xc9, 0xc5, 0xcd, 0x42,
+	0x43, 0xb7, 0x0b, 0x0d, 0xfd, 0x59, 0x68, 0xe8, 0xc7, 0x52, 0x53, 0x6e, 0x97, 0x9a, 0xf2, 0x6b,
+	0xa9, 0x29, 0x5f, 0x9c, 0x38, 0x85, 0x64, 0x3e, 0x35, 0x23, 0x71, 0x65, 0xd5, 0x4b, 0xcc, 0x84,
+	0x28, 0xd2, 0x3c, 0xb2, 0xba, 0x85, 0xbe, 0xe9, 0x7e, 0xfd, 0xa6, 0x53, 0x98, 0xf6, 0x9b, 0x1b,
+	0x78, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x91, 0x03, 0xad, 0x8f, 0x02, 0x00, 0x00,
+}
+
+func (m *RetryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PruneHeight != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.PruneHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintRetry(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NextAttemptHeight != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.NextAttemptHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Restake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RetryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRetry(uint64(m.Id))
+	}
+	l = m.Restake.Size()
+	n += 1 + l + sovRetry(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRetry(uint64(m.Status))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovRetry(uint64(m.Attempts))
+	}
+	if m.NextAttemptHeight != 0 {
+		n += 1 + sovRetry(uint64(m.NextAttemptHeight))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	if m.PruneHeight != 0 {
+		n += 1 + sovRetry(uint64(m.PruneHeight))
+	}
+	return n
+}
+
+func sovRetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRetry(x uint64) (n int) {
+	return sovRetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RetryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RetryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptHeight", wireType)
+			}
+			m.NextAttemptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneHeight", wireType)
+			}
+			m.PruneHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRetry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRetry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRetry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRetry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRetry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRetry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRetry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRetry = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,3484 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/tx.proto
+
+package v1
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetValidatorOverride is the Msg/SetValidatorOverride request type.
+type MsgSetValidatorOverride struct {
+	// validator_address is the operator address of the validator; it must sign.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// ratio is the share of rewards restaked for this validator's delegators.
+	Ratio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *MsgSetValidatorOverride) Reset()         { *m = MsgSetValidatorOverride{} }
+func (m *MsgSetValidatorOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorOverride) ProtoMessage()    {}
+func (*MsgSetValidatorOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{2}
+}
+func (m *MsgSetValidatorOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorOverride.Merge(m, src)
+}
+func (m *MsgSetValidatorOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorOverride proto.InternalMessageInfo
+
+func (m *MsgSetValidatorOverride) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgSetValidatorOverrideResponse defines the response structure for executing a
+// MsgSetValidatorOverride message.
+type MsgSetValidatorOverrideResponse struct {
+}
+
+func (m *MsgSetValidatorOverrideResponse) Reset()         { *m = MsgSetValidatorOverrideResponse{} }
+func (m *MsgSetValidatorOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorOverrideResponse) ProtoMessage()    {}
+func (*MsgSetValidatorOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{3}
+}
+func (m *MsgSetValidatorOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorOverrideResponse proto.InternalMessageInfo
+
+// MsgClearValidatorOverride is the Msg/ClearValidatorOverride request type.
+type MsgClearValidatorOverride struct {
+	// validator_address is the operator address of the validator; it must sign.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgClearValidatorOverride) Reset()         { *m = MsgClearValidatorOverride{} }
+func (m *MsgClearValidatorOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgClearValidatorOverride) ProtoMessage()    {}
+func (*MsgClearValidatorOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{4}
+}
+func (m *MsgClearValidatorOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearValidatorOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearValidatorOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearValidatorOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearValidatorOverride.Merge(m, src)
+}
+func (m *MsgClearValidatorOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearValidatorOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearValidatorOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearValidatorOverride proto.InternalMessageInfo
+
+func (m *MsgClearValidatorOverride) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgClearValidatorOverrideResponse defines the response structure for executing a
+// MsgClearValidatorOverride message.
+type MsgClearValidatorOverrideResponse struct {
+}
+
+func (m *MsgClearValidatorOverrideResponse) Reset()         { *m = MsgClearValidatorOverrideResponse{} }
+func (m *MsgClearValidatorOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearValidatorOverrideResponse) ProtoMessage()    {}
+func (*MsgClearValidatorOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{5}
+}
+func (m *MsgClearValidatorOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearValidatorOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearValidatorOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearValidatorOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearValidatorOverrideResponse.Merge(m, src)
+}
+func (m *MsgClearValidatorOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearValidatorOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearValidatorOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearValidatorOverrideResponse proto.InternalMessageInfo
+
+// MsgSetDelegatorPreference is the Msg/SetDelegatorPreference request type.
+type MsgSetDelegatorPreference struct {
+	// delegator_address is the delegator whose preference is set; it must sign.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// disabled opts the delegator out of auto-restake.
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// ratio is an optional custom ratio applied instead of the validator
+	// override and global ratio.
+	Ratio *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio,omitempty"`
+	// validator_preferences are optional per-validator entries.
+	ValidatorPreferences []ValidatorPreference `protobuf:"bytes,4,rep,name=validator_preferences,json=validatorPreferences,proto3" json:"validator_preferences"`
+	// fallback_validator_address is an optional validator that receives restakes
+	// redirected away from unhealthy validators.
+	FallbackValidatorAddress string `protobuf:"bytes,5,opt,name=fallback_validator_address,json=fallbackValidatorAddress,proto3" json:"fallback_validator_address,omitempty"`
+	// diversification_targets is an optional weighted validator set every
+	// restake is split across.
+	DiversificationTargets []DiversificationTarget `protobuf:"bytes,6,rep,name=diversification_targets,json=diversificationTargets,proto3" json:"diversification_targets"`
+}
+
+func (m *MsgSetDelegatorPreference) Reset()         { *m = MsgSetDelegatorPreference{} }
+func (m *MsgSetDelegatorPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegatorPreference) ProtoMessage()    {}
+func (*MsgSetDelegatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{6}
+}
+func (m *MsgSetDelegatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegatorPreference.Merge(m, src)
+}
+func (m *MsgSetDelegatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDelegatorPreference proto.InternalMessageInfo
+
+func (m *MsgSetDelegatorPreference) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetDelegatorPreference) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *MsgSetDelegatorPreference) GetValidatorPreferences() []ValidatorPreference {
+	if m != nil {
+		return m.ValidatorPreferences
+	}
+	return nil
+}
+
+func (m *MsgSetDelegatorPreference) GetFallbackValidatorAddress() string {
+	if m != nil {
+		return m.FallbackValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetDelegatorPreference) GetDiversificationTargets() []DiversificationTarget {
+	if m != nil {
+		return m.DiversificationTargets
+	}
+	return nil
+}
+
+// MsgSetDelegatorPreferenceResponse defines the response structure for executing a
+// MsgSetDelegatorPreference message.
+type MsgSetDelegatorPreferenceResponse struct {
+}
+
+func (m *MsgSetDelegatorPreferenceResponse) Reset()         { *m = MsgSetDelegatorPreferenceResponse{} }
+func (m *MsgSetDelegatorPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegatorPreferenceResponse) ProtoMessage()    {}
+func (*MsgSetDelegatorPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{7}
+}
+func (m *MsgSetDelegatorPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegatorPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegatorPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegatorPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegatorPreferenceResponse.Merge(m, src)
+}
+func (m *MsgSetDelegatorPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegatorPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegatorPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDelegatorPreferenceResponse proto.InternalMessageInfo
+
+// MsgClearDelegatorPreference is the Msg/ClearDelegatorPreference request type.
+type MsgClearDelegatorPreference struct {
+	// delegator_address is the delegator whose preference is removed; it must sign.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgClearDelegatorPreference) Reset()         { *m = MsgClearDelegatorPreference{} }
+func (m *MsgClearDelegatorPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgClearDelegatorPreference) ProtoMessage()    {}
+func (*MsgClearDelegatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{8}
+}
+func (m *MsgClearDelegatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearDelegatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearDelegatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearDelegatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearDelegatorPreference.Merge(m, src)
+}
+func (m *MsgClearDelegatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearDelegatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearDelegatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearDelegatorPreference proto.InternalMessageInfo
+
+func (m *MsgClearDelegatorPreference) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// MsgClearDelegatorPreferenceResponse defines the response structure for executing a
+// MsgClearDelegatorPreference message.
+type MsgClearDelegatorPreferenceResponse struct {
+}
+
+func (m *MsgClearDelegatorPreferenceResponse) Reset()         { *m = MsgClearDelegatorPreferenceResponse{} }
+func (m *MsgClearDelegatorPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearDelegatorPreferenceResponse) ProtoMessage()    {}
+func (*MsgClearDelegatorPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{9}
+}
+func (m *MsgClearDelegatorPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearDelegatorPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearDelegatorPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearDelegatorPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearDelegatorPreferenceResponse.Merge(m, src)
+}
+func (m *MsgClearDelegatorPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearDelegatorPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearDelegatorPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearDelegatorPreferenceResponse proto.InternalMessageInfo
+
+// MsgCancelRetry is the Msg/CancelRetry request type.
+type MsgCancelRetry struct {
+	// delegator_address is the delegator owning the retry; it must sign.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// id is the id of the retry entry.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelRetry) Reset()         { *m = MsgCancelRetry{} }
+func (m *MsgCancelRetry) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRetry) ProtoMessage()    {}
+func (*MsgCancelRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{10}
+}
+func (m *MsgCancelRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRetry.Merge(m, src)
+}
+func (m *MsgCancelRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRetry proto.InternalMessageInfo
+
+func (m *MsgCancelRetry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelRetry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelRetryResponse defines the response structure for executing a
+// MsgCancelRetry message.
+type MsgCancelRetryResponse struct {
+}
+
+func (m *MsgCancelRetryResponse) Reset()         { *m = MsgCancelRetryResponse{} }
+func (m *MsgCancelRetryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRetryResponse) ProtoMessage()    {}
+func (*MsgCancelRetryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{11}
+}
+func (m *MsgCancelRetryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRetryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRetryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRetryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRetryResponse.Merge(m, src)
+}
+func (m *MsgCancelRetryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRetryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRetryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRetryResponse proto.InternalMessageInfo
+
+// MsgForceRetry is the Msg/ForceRetry request type.
+type MsgForceRetry struct {
+	// delegator_address is the delegator owning the retry; it must sign.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// id is the id of the retry entry.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgForceRetry) Reset()         { *m = MsgForceRetry{} }
+func (m *MsgForceRetry) String() string { return proto.CompactTextString(m) }
+func (*MsgForceRetry) ProtoMessage()    {}
+func (*MsgForceRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{12}
+}
+func (m *MsgForceRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceRetry.Merge(m, src)
+}
+func (m *MsgForceRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceRetry proto.InternalMessageInfo
+
+func (m *MsgForceRetry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgForceRetry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgForceRetryResponse defines the response structure for executing a
+// MsgForceRetry message.
+type MsgForceRetryResponse struct {
+}
+
+func (m *MsgForceRetryResponse) Reset()         { *m = MsgForceRetryResponse{} }
+func (m *MsgForceRetryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceRetryResponse) ProtoMessage()    {}
+func (*MsgForceRetryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{13}
+}
+func (m *MsgForceRetryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceRetryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceRetryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceRetryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceRetryResponse.Merge(m, src)
+}
+func (m *MsgForceRetryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceRetryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceRetryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceRetryResponse proto.InternalMessageInfo
+
+// MsgClaimCarryOver is the Msg/ClaimCarryOver request type.
+type MsgClaimCarryOver struct {
+	// delegator_address is the delegator owning the carry-over; it must sign.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address limits the claim to the carry-over of rewards paid by
+	// the validator. All of the delegator's carry-over is claimed when it is
+	// empty.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgClaimCarryOver) Reset()         { *m = MsgClaimCarryOver{} }
+func (m *MsgClaimCarryOver) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimCarryOver) ProtoMessage()    {}
+func (*MsgClaimCarryOver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{14}
+}
+func (m *MsgClaimCarryOver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimCarryOver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimCarryOver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimCarryOver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimCarryOver.Merge(m, src)
+}
+func (m *MsgClaimCarryOver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimCarryOver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimCarryOver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimCarryOver proto.InternalMessageInfo
+
+func (m *MsgClaimCarryOver) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgClaimCarryOver) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgClaimCarryOverResponse defines the response structure for executing a
+// MsgClaimCarryOver message.
+type MsgClaimCarryOverResponse struct {
+	// amount is the carry-over paid back to the delegator.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgClaimCarryOverResponse) Reset()         { *m = MsgClaimCarryOverResponse{} }
+func (m *MsgClaimCarryOverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimCarryOverResponse) ProtoMessage()    {}
+func (*MsgClaimCarryOverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc5dc88dcb212a96, []int{15}
+}
+func (m *MsgClaimCarryOverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimCarryOverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimCarryOverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimCarryOverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimCarryOverResponse.Merge(m, src)
+}
+func (m *MsgClaimCarryOverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimCarryOverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimCarryOverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimCarryOverResponse proto.InternalMessageInfo
+
+func (m *MsgClaimCarryOverResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "lyfeblocnetwork.restaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetValidatorOverride)(nil), "lyfeblocnetwork.restaking.v1.MsgSetValidatorOverride")
+	proto.RegisterType((*MsgSetValidatorOverrideResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgSetValidatorOverrideResponse")
+	proto.RegisterType((*MsgClearValidatorOverride)(nil), "lyfeblocnetwork.restaking.v1.MsgClearValidatorOverride")
+	proto.RegisterType((*MsgClearValidatorOverrideResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgClearValidatorOverrideResponse")
+	proto.RegisterType((*MsgSetDelegatorPreference)(nil), "lyfeblocnetwork.restaking.v1.MsgSetDelegatorPreference")
+	proto.RegisterType((*MsgSetDelegatorPreferenceResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgSetDelegatorPreferenceResponse")
+	proto.RegisterType((*MsgClearDelegatorPreference)(nil), "lyfeblocnetwork.restaking.v1.MsgClearDelegatorPreference")
+	proto.RegisterType((*MsgClearDelegatorPreferenceResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgClearDelegatorPreferenceResponse")
+	proto.RegisterType((*MsgCancelRetry)(nil), "lyfeblocnetwork.restaking.v1.MsgCancelRetry")
+	proto.RegisterType((*MsgCancelRetryResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgCancelRetryResponse")
+	proto.RegisterType((*MsgForceRetry)(nil), "lyfeblocnetwork.restaking.v1.MsgForceRetry")
+	proto.RegisterType((*MsgForceRetryResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgForceRetryResponse")
+	proto.RegisterType((*MsgClaimCarryOver)(nil), "lyfeblocnetwork.restaking.v1.MsgClaimCarryOver")
+	proto.RegisterType((*MsgClaimCarryOverResponse)(nil), "lyfeblocnetwork.restaking.v1.MsgClaimCarryOverResponse")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/tx.proto", fileDescriptor_fc5dc88dcb212a96)
+}
+
+var fileDescriptor_fc5dc88dcb212a96 = []byte{
+	// 1028 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcb, 0x4f, 0x24, 0x45,
+	0x18, 0xc0, 0xe9, 0xe1, 0x11, 0x28, 0x14, 0x97, 0x0e, 0x0b, 0x43, 0xaf, 0x0e, 0x30, 0xeb, 0x26,
+	0x2c, 0xeb, 0x74, 0x67, 0xc0, 0x5d, 0xe2, 0xc4, 0x47, 0x76, 0x98, 0x55, 0x0f, 0xcb, 0x4a, 0x06,
+	0x35, 0xd1, 0x83, 0x93, 0x9a, 0xee, 0xa2, 0xa9, 0xd0, 0xd3, 0x35, 0x56, 0x15, 0xe3, 0x8e, 0x27,
+	0xe3, 0xc5, 0xc4, 0x78, 0x30, 0x9e, 0xfc, 0x13, 0x3c, 0x18, 0xc3, 0x01, 0x13, 0x2f, 0xde, 0xf7,
+	0xb8, 0xe1, 0xb4, 0xf1, 0xb0, 0x31, 0x70, 0xe0, 0x1f, 0xf0, 0x0f, 0x30, 0xfd, 0xaa, 0x9e, 0x69,
+	0xfa, 0x31, 0x43, 0xe0, 0x42, 0xe8, 0xef, 0xfd, 0xfb, 0xe6, 0xab, 0xaf, 0xab, 0xc1, 0x1d, 0xab,
+	0xbb, 0x87, 0x9a, 0x16, 0xd1, 0x6d, 0xc4, 0xbf, 0x21, 0xf4, 0x40, 0xa3, 0x88, 0x71, 0x78, 0x80,
+	0x6d, 0x53, 0xeb, 0x94, 0x35, 0xfe, 0x54, 0x6d, 0x53, 0xc2, 0x89, 0xfc, 0x7a, 0xc4, 0x4c, 0x15,
+	0x66, 0x6a, 0xa7, 0xac, 0xcc, 0xc2, 0x16, 0xb6, 0x89, 0xe6, 0xfe, 0xf5, 0x1c, 0x94, 0x82, 0x4e,
+	0x58, 0x8b, 0x30, 0xad, 0x09, 0x19, 0xd2, 0x3a, 0xe5, 0x26, 0xe2, 0xb0, 0xac, 0xe9, 0x04, 0xdb,
+	0xbe, 0x7e, 0xc1, 0xd7, 0xb7, 0x98, 0x9b, 0xa8, 0xc5, 0x4c, 0x5f, 0xb1, 0xe8, 0x29, 0x1a, 0xee,
+	0x93, 0xe6, 0x3d, 0xf8, 0xaa, 0x39, 0x93, 0x98, 0xc4, 0x93, 0x3b, 0xff, 0xf9, 0xd2, 0xbb, 0xa9,
+	0x04, 0x6d, 0x48, 0x61, 0x2b, 0x08, 0x50, 0x4a, 0x37, 0xa5, 0x68, 0x0f, 0x51, 0x64, 0xeb, 0xc8,
+	0x33, 0x2f, 0x9e, 0x48, 0xe0, 0xb5, 0x6d, 0x66, 0x7e, 0xd6, 0x36, 0x20, 0x47, 0x3b, 0x6e, 0x20,
+	0xf9, 0x01, 0x98, 0x82, 0x87, 0x7c, 0x9f, 0x50, 0xcc, 0xbb, 0x79, 0x69, 0x59, 0x5a, 0x9d, 0xaa,
+	0xe6, 0x4f, 0x8e, 0x4b, 0x73, 0x7e, 0xa1, 0x0f, 0x0d, 0x83, 0x22, 0xc6, 0x76, 0x39, 0xc5, 0xb6,
+	0x59, 0x0f, 0x4d, 0xe5, 0x8f, 0xc0, 0x84, 0x57, 0x4a, 0x3e, 0xb7, 0x2c, 0xad, 0x4e, 0xaf, 0xbf,
+	0xa9, 0xa6, 0x75, 0x54, 0xf5, 0xb2, 0x55, 0xa7, 0x9e, 0xbd, 0x5c, 0x1a, 0xf9, 0xed, 0xfc, 0x68,
+	0x4d, 0xaa, 0xfb, 0xee, 0x95, 0xf7, 0xbf, 0x3f, 0x3f, 0x5a, 0x0b, 0x03, 0xff, 0x78, 0x7e, 0xb4,
+	0x76, 0x2f, 0x8a, 0xf5, 0xb4, 0x07, 0x2c, 0x02, 0x50, 0x5c, 0x04, 0x0b, 0x11, 0x51, 0x1d, 0xb1,
+	0x36, 0xb1, 0x19, 0x2a, 0xfe, 0x90, 0x73, 0x75, 0xbb, 0x88, 0x7f, 0x0e, 0x2d, 0x6c, 0x40, 0x4e,
+	0xe8, 0x27, 0x1d, 0x44, 0x29, 0x36, 0x90, 0xfc, 0x04, 0xcc, 0x76, 0x02, 0x61, 0x03, 0x7a, 0x94,
+	0x3e, 0xff, 0xca, 0xc9, 0x71, 0xe9, 0x0d, 0x9f, 0x5f, 0x38, 0xf6, 0x37, 0xe2, 0x46, 0x27, 0x22,
+	0x97, 0x1f, 0x83, 0x71, 0x0a, 0x39, 0x26, 0x6e, 0x3b, 0xa6, 0xaa, 0x0f, 0x1c, 0xd0, 0x7f, 0x5e,
+	0x2e, 0xdd, 0xf2, 0xe2, 0x30, 0xe3, 0x40, 0xc5, 0x44, 0x6b, 0x41, 0xbe, 0xaf, 0x3e, 0x46, 0x26,
+	0xd4, 0xbb, 0x35, 0xa4, 0x9f, 0x1c, 0x97, 0x80, 0x9f, 0xa6, 0x86, 0x74, 0xaf, 0x2b, 0x5e, 0x90,
+	0xca, 0x13, 0xa7, 0x29, 0x17, 0x0b, 0x74, 0x9a, 0xb3, 0x91, 0xd1, 0x9c, 0x38, 0xda, 0xe2, 0x0a,
+	0x58, 0x4a, 0x50, 0x89, 0x66, 0xfd, 0x2d, 0x81, 0xc5, 0x6d, 0x66, 0x6e, 0x59, 0x08, 0xd2, 0x6b,
+	0x6f, 0x57, 0x65, 0x27, 0x19, 0xf0, 0x7e, 0x06, 0x60, 0x7c, 0x85, 0xc5, 0xdb, 0x60, 0x25, 0x51,
+	0x29, 0x20, 0x5f, 0x8c, 0xb9, 0x90, 0xbb, 0x88, 0xd7, 0x90, 0x85, 0x4c, 0xc7, 0x66, 0x47, 0x9c,
+	0x12, 0xf9, 0x11, 0x98, 0x35, 0x02, 0x71, 0x04, 0x32, 0xf9, 0x4c, 0xdc, 0x10, 0x2e, 0xc1, 0x28,
+	0x28, 0x60, 0xd2, 0xc0, 0x0c, 0x36, 0x2d, 0x64, 0xb8, 0xd3, 0x30, 0x59, 0x17, 0xcf, 0xf2, 0x56,
+	0x30, 0x26, 0xa3, 0x6e, 0xd8, 0xd2, 0x50, 0x23, 0xe2, 0x4f, 0x87, 0x6c, 0x81, 0x9b, 0x61, 0xe7,
+	0xc2, 0x53, 0xce, 0xf2, 0x63, 0xcb, 0xa3, 0xab, 0xd3, 0xeb, 0xe5, 0xf4, 0xa3, 0x28, 0xba, 0x13,
+	0x92, 0x57, 0xc7, 0x9c, 0x71, 0xad, 0xcf, 0x75, 0x2e, 0xaa, 0x98, 0xdc, 0x00, 0xca, 0x1e, 0xb4,
+	0xac, 0x26, 0xd4, 0x0f, 0x1a, 0x17, 0x67, 0x60, 0x7c, 0xd0, 0x19, 0xc8, 0x07, 0x41, 0xa2, 0x7a,
+	0x99, 0x82, 0x05, 0x03, 0x77, 0x10, 0x65, 0x78, 0x0f, 0xeb, 0x0e, 0xa0, 0xdd, 0xe0, 0x90, 0x9a,
+	0x88, 0xb3, 0xfc, 0x84, 0x0b, 0xb4, 0x91, 0x0e, 0x54, 0xeb, 0x77, 0xfe, 0xd4, 0xf5, 0xf5, 0x91,
+	0xe6, 0x8d, 0x38, 0x65, 0x30, 0x7f, 0x17, 0x7e, 0xed, 0x41, 0xe6, 0x2f, 0x7e, 0x78, 0xfc, 0xf9,
+	0x8b, 0x57, 0x8a, 0xf9, 0xfb, 0x4b, 0x02, 0xb7, 0x82, 0x29, 0xbd, 0xbe, 0x09, 0xac, 0xd4, 0x93,
+	0xe9, 0x36, 0x07, 0x39, 0x5d, 0x71, 0x7c, 0x77, 0xc0, 0xed, 0x14, 0xb5, 0x20, 0xfc, 0x43, 0x02,
+	0x33, 0x8e, 0x1d, 0xb4, 0x75, 0x64, 0xd5, 0x11, 0xa7, 0xdd, 0xab, 0x3a, 0x56, 0x33, 0x20, 0x87,
+	0xbd, 0x03, 0x35, 0x56, 0xcf, 0x61, 0xa3, 0xf2, 0x28, 0x19, 0x72, 0x2d, 0x0b, 0x32, 0xac, 0xae,
+	0x98, 0x07, 0xf3, 0xfd, 0x12, 0x81, 0xf2, 0xbb, 0x04, 0x5e, 0xdd, 0x66, 0xe6, 0x87, 0x84, 0x3a,
+	0x7c, 0xd7, 0x48, 0x52, 0x4b, 0x26, 0xb9, 0x9b, 0x41, 0x12, 0x16, 0x57, 0x5c, 0x00, 0x37, 0xfb,
+	0x04, 0x82, 0xe3, 0x3f, 0x09, 0xcc, 0xba, 0x3f, 0x1d, 0xc4, 0xad, 0x2d, 0x48, 0x69, 0xd7, 0x59,
+	0x8b, 0x57, 0xc5, 0x12, 0xfb, 0x62, 0xc8, 0x5d, 0xfe, 0xc5, 0xf0, 0x71, 0x72, 0x2f, 0x4a, 0x99,
+	0xa3, 0xdb, 0x0b, 0x58, 0xfc, 0xc2, 0x7f, 0x9f, 0xf5, 0x0a, 0x83, 0x9e, 0xc8, 0xef, 0x82, 0x09,
+	0xd8, 0x22, 0x87, 0x36, 0x77, 0x91, 0xa7, 0xd7, 0x17, 0x55, 0xbf, 0x50, 0xe7, 0x7e, 0xa7, 0xfa,
+	0xf7, 0x3b, 0x75, 0x8b, 0x60, 0xbb, 0xef, 0xce, 0xe2, 0xf9, 0xac, 0xff, 0x39, 0x09, 0x46, 0xb7,
+	0x99, 0x29, 0x73, 0xf0, 0x4a, 0xdf, 0x65, 0xaa, 0x94, 0xbe, 0xa8, 0x22, 0xf7, 0x14, 0xe5, 0xfe,
+	0x50, 0xe6, 0xa2, 0xf6, 0x9f, 0x24, 0x30, 0x17, 0x7b, 0xa7, 0xc9, 0x8e, 0x17, 0xe7, 0xa6, 0xbc,
+	0x77, 0x29, 0x37, 0x51, 0xce, 0x2f, 0x12, 0x98, 0x4f, 0xb8, 0x35, 0x6c, 0x66, 0x46, 0x8e, 0x77,
+	0x54, 0x3e, 0xb8, 0xa4, 0x63, 0x5f, 0x51, 0x09, 0x6f, 0xf9, 0xcd, 0x41, 0x70, 0x63, 0x1c, 0x07,
+	0x28, 0x2a, 0x7d, 0xfb, 0xcb, 0xbf, 0x4a, 0x20, 0x9f, 0xb8, 0xfa, 0xdf, 0x19, 0x0c, 0x39, 0xae,
+	0xb0, 0x87, 0x97, 0x76, 0x15, 0xa5, 0x7d, 0x0d, 0xa6, 0x7b, 0x57, 0xf6, 0x5b, 0xd9, 0x11, 0x43,
+	0x6b, 0xe5, 0xed, 0x61, 0xac, 0x45, 0x4a, 0x1b, 0x80, 0x9e, 0xd5, 0x7a, 0x2f, 0x33, 0x46, 0x68,
+	0xac, 0x6c, 0x0c, 0x61, 0x2c, 0xf2, 0x7d, 0x0b, 0x66, 0x22, 0x2b, 0x50, 0x1b, 0xa0, 0x6f, 0xbd,
+	0x0e, 0xca, 0xe6, 0x90, 0x0e, 0x41, 0x6e, 0x65, 0xfc, 0x3b, 0x67, 0x7f, 0x54, 0xbf, 0x7a, 0x76,
+	0x5a, 0x90, 0x9e, 0x9f, 0x16, 0xa4, 0x7f, 0x4f, 0x0b, 0xd2, 0xcf, 0x67, 0x85, 0x91, 0xe7, 0x67,
+	0x85, 0x91, 0x17, 0x67, 0x85, 0x91, 0x2f, 0x6b, 0x26, 0xe6, 0xfb, 0x87, 0x4d, 0x55, 0x27, 0x2d,
+	0xcd, 0xc9, 0x61, 0x11, 0xd2, 0xc6, 0xb6, 0xae, 0x05, 0xf9, 0x4a, 0xc1, 0xce, 0x4b, 0xfb, 0xe2,
+	0x6b, 0x4e, 0xb8, 0xdf, 0x79, 0x1b, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x66, 0xff, 0xd7,
+	0x05, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetValidatorOverride sets the auto-restake ratio applied to rewards paid
+	// by the signing validator. The ratio must lie within the governance
+	// min/max bounds.
+	SetValidatorOverride(ctx context.Context, in *MsgSetValidatorOverride, opts ...grpc.CallOption) (*MsgSetValidatorOverrideResponse, error)
+	// ClearValidatorOverride removes the signing validator's override so the
+	// global ratio applies again.
+	ClearValidatorOverride(ctx context.Context, in *MsgClearValidatorOverride, opts ...grpc.CallOption) (*MsgClearValidatorOverrideResponse, error)
+	// SetDelegatorPreference replaces the signing delegator's auto-restake
+	// preference.
+	SetDelegatorPreference(ctx context.Context, in *MsgSetDelegatorPreference, opts ...grpc.CallOption) (*MsgSetDelegatorPreferenceResponse, error)
+	// ClearDelegatorPreference removes the signing delegator's preference so the
+	// validator override and global ratio apply again.
+	ClearDelegatorPreference(ctx context.Context, in *MsgClearDelegatorPreference, opts ...grpc.CallOption) (*MsgClearDelegatorPreferenceResponse, error)
+	// CancelRetry drops one of the signing delegator's queued auto-restake
+	// retries.
+	CancelRetry(ctx context.Context, in *MsgCancelRetry, opts ...grpc.CallOption) (*MsgCancelRetryResponse, error)
+	// ForceRetry retries one of the signing delegator's queued auto-restakes
+	// immediately, whatever its status and backoff.
+	ForceRetry(ctx context.Context, in *MsgForceRetry, opts ...grpc.CallOption) (*MsgForceRetryResponse, error)
+	// ClaimCarryOver pays the signing delegator's carry-over back to it instead
+	// of waiting for it to be restaked.
+	ClaimCarryOver(ctx context.Context, in *MsgClaimCarryOver, opts ...grpc.CallOption) (*MsgClaimCarryOverResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorOverride(ctx context.Context, in *MsgSetValidatorOverride, opts ...grpc.CallOption) (*MsgSetValidatorOverrideResponse, error) {
+	out := new(MsgSetValidatorOverrideResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/SetValidatorOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearValidatorOverride(ctx context.Context, in *MsgClearValidatorOverride, opts ...grpc.CallOption) (*MsgClearValidatorOverrideResponse, error) {
+	out := new(MsgClearValidatorOverrideResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/ClearValidatorOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDelegatorPreference(ctx context.Context, in *MsgSetDelegatorPreference, opts ...grpc.CallOption) (*MsgSetDelegatorPreferenceResponse, error) {
+	out := new(MsgSetDelegatorPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/SetDelegatorPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearDelegatorPreference(ctx context.Context, in *MsgClearDelegatorPreference, opts ...grpc.CallOption) (*MsgClearDelegatorPreferenceResponse, error) {
+	out := new(MsgClearDelegatorPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/ClearDelegatorPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRetry(ctx context.Context, in *MsgCancelRetry, opts ...grpc.CallOption) (*MsgCancelRetryResponse, error) {
+	out := new(MsgCancelRetryResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/CancelRetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceRetry(ctx context.Context, in *MsgForceRetry, opts ...grpc.CallOption) (*MsgForceRetryResponse, error) {
+	out := new(MsgForceRetryResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/ForceRetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimCarryOver(ctx context.Context, in *MsgClaimCarryOver, opts ...grpc.CallOption) (*MsgClaimCarryOverResponse, error) {
+	out := new(MsgClaimCarryOverResponse)
+	err := c.cc.Invoke(ctx, "/lyfeblocnetwork.restaking.v1.Msg/ClaimCarryOver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetValidatorOverride sets the auto-restake ratio applied to rewards paid
+	// by the signing validator. The ratio must lie within the governance
+	// min/max bounds.
+	SetValidatorOverride(context.Context, *MsgSetValidatorOverride) (*MsgSetValidatorOverrideResponse, error)
+	// ClearValidatorOverride removes the signing validator's override so the
+	// global ratio applies again.
+	ClearValidatorOverride(context.Context, *MsgClearValidatorOverride) (*MsgClearValidatorOverrideResponse, error)
+	// SetDelegatorPreference replaces the signing delegator's auto-restake
+	// preference.
+	SetDelegatorPreference(context.Context, *MsgSetDelegatorPreference) (*MsgSetDelegatorPreferenceResponse, error)
+	// ClearDelegatorPreference removes the signing delegator's preference so the
+	// validator override and global ratio apply again.
+	ClearDelegatorPreference(context.Context, *MsgClearDelegatorPreference) (*MsgClearDelegatorPreferenceResponse, error)
+	// CancelRetry drops one of the signing delegator's queued auto-restake
+	// retries.
+	CancelRetry(context.Context, *MsgCancelRetry) (*MsgCancelRetryResponse, error)
+	// ForceRetry retries one of the signing delegator's queued auto-restakes
+	// immediately, whatever its status and backoff.
+	ForceRetry(context.Context, *MsgForceRetry) (*MsgForceRetryResponse, error)
+	// ClaimCarryOver pays the signing delegator's carry-over back to it instead
+	// of waiting for it to be restaked.
+	ClaimCarryOver(context.Context, *MsgClaimCarryOver) (*MsgClaimCarryOverResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorOverride(ctx context.Context, req *MsgSetValidatorOverride) (*MsgSetValidatorOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorOverride not implemented")
+}
+func (*UnimplementedMsgServer) ClearValidatorOverride(ctx context.Context, req *MsgClearValidatorOverride) (*MsgClearValidatorOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearValidatorOverride not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegatorPreference(ctx context.Context, req *MsgSetDelegatorPreference) (*MsgSetDelegatorPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegatorPreference not implemented")
+}
+func (*UnimplementedMsgServer) ClearDelegatorPreference(ctx context.Context, req *MsgClearDelegatorPreference) (*MsgClearDelegatorPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearDelegatorPreference not implemented")
+}
+func (*UnimplementedMsgServer) CancelRetry(ctx context.Context, req *MsgCancelRetry) (*MsgCancelRetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRetry not implemented")
+}
+func (*UnimplementedMsgServer) ForceRetry(ctx context.Context, req *MsgForceRetry) (*MsgForceRetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceRetry not implemented")
+}
+func (*UnimplementedMsgServer) ClaimCarryOver(ctx context.Context, req *MsgClaimCarryOver) (*MsgClaimCarryOverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCarryOver not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/SetValidatorOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorOverride(ctx, req.(*MsgSetValidatorOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearValidatorOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearValidatorOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearValidatorOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/ClearValidatorOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearValidatorOverride(ctx, req.(*MsgClearValidatorOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegatorPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegatorPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegatorPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/SetDelegatorPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegatorPreference(ctx, req.(*MsgSetDelegatorPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearDelegatorPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearDelegatorPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearDelegatorPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/ClearDelegatorPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearDelegatorPreference(ctx, req.(*MsgClearDelegatorPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRetry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/CancelRetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRetry(ctx, req.(*MsgCancelRetry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceRetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceRetry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceRetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/ForceRetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceRetry(ctx, req.(*MsgForceRetry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimCarryOver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimCarryOver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimCarryOver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lyfeblocnetwork.restaking.v1.Msg/ClaimCarryOver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimCarryOver(ctx, req.(*MsgClaimCarryOver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lyfeblocnetwork.restaking.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetValidatorOverride",
+			Handler:    _Msg_SetValidatorOverride_Handler,
+		},
+		{
+			MethodName: "ClearValidatorOverride",
+			Handler:    _Msg_ClearValidatorOverride_Handler,
+		},
+		{
+			MethodName: "SetDelegatorPreference",
+			Handler:    _Msg_SetDelegatorPreference_Handler,
+		},
+		{
+			MethodName: "ClearDelegatorPreference",
+			Handler:    _Msg_ClearDelegatorPreference_Handler,
+		},
+		{
+			MethodName: "CancelRetry",
+			Handler:    _Msg_CancelRetry_Handler,
+		},
+		{
+			MethodName: "ForceRetry",
+			Handler:    _Msg_ForceRetry_Handler,
+		},
+		{
+			MethodName: "ClaimCarryOver",
+			Handler:    _Msg_ClaimCarryOver_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lyfeblocnetwork/restaking/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearValidatorOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearValidatorOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearValidatorOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearValidatorOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearValidatorOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearValidatorOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiversificationTargets) > 0 {
+		for iNdEx := len(m.DiversificationTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DiversificationTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FallbackValidatorAddress) > 0 {
+		i -= len(m.FallbackValidatorAddress)
+		copy(dAtA[i:], m.FallbackValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for iNdEx := len(m.ValidatorPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Ratio != nil {
+		{
+			size := m.Ratio.Size()
+			i -= size
+			if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegatorPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegatorPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegatorPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearDelegatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearDelegatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearDelegatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearDelegatorPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearDelegatorPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearDelegatorPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRetryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRetryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRetryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceRetryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceRetryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceRetryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimCarryOver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimCarryOver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimCarryOver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimCarryOverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimCarryOverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimCarryOverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetValidatorOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetValidatorOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearValidatorOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearValidatorOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDelegatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.Ratio != nil {
+		l = m.Ratio.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for _, e := range m.ValidatorPreferences {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FallbackValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DiversificationTargets) > 0 {
+		for _, e := range m.DiversificationTargets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDelegatorPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearDelegatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearDelegatorPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRetryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgForceRetryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimCarryOver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimCarryOverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearValidatorOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearValidatorOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearValidatorOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearValidatorOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearValidatorOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearValidatorOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDelegatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDelegatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDelegatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Ratio = &v
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPreferences = append(m.ValidatorPreferences, ValidatorPreference{})
+			if err := m.ValidatorPreferences[len(m.ValidatorPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiversificationTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiversificationTargets = append(m.DiversificationTargets, DiversificationTarget{})
+			if err := m.DiversificationTargets[len(m.DiversificationTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDelegatorPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDelegatorPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDelegatorPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearDelegatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearDelegatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearDelegatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearDelegatorPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearDelegatorPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearDelegatorPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRetryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRetryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRetryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceRetryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceRetryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceRetryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimCarryOver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCarryOver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCarryOver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimCarryOverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCarryOverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCarryOverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/restaking/v1/withdrawal.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardWithdrawal is a delegator reward withdrawal awaiting auto-restake. It
+// is queued once its transaction succeeds and processed at the next end block
+// the per-block restake budget allows.
+type RewardWithdrawal struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the rewards paid out to the delegator by the withdrawal.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *RewardWithdrawal) Reset()         { *m = RewardWithdrawal{} }
+func (m *RewardWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*RewardWithdrawal) ProtoMessage()    {}
+func (*RewardWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7403395bf5ab6e6e, []int{0}
+}
+func (m *RewardWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWithdrawal.Merge(m, src)
+}
+func (m *RewardWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWithdrawal proto.InternalMessageInfo
+
+func (m *RewardWithdrawal) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *RewardWithdrawal) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RewardWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardWithdrawal)(nil), "lyfeblocnetwork.restaking.v1.RewardWithdrawal")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/restaking/v1/withdrawal.proto", fileDescriptor_7403395bf5ab6e6e)
+}
+
+var fileDescriptor_7403395bf5ab6e6e = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x5b, 0x48, 0x48, 0x6e, 0xef, 0x06, 0x1a, 0x16, 0x40, 0xee, 0x2d, 0xdc, 0xbb, 0x22,
+	0x26, 0xed, 0xa4, 0x1a, 0x1f, 0x40, 0xd4, 0xad, 0x0b, 0x4c, 0x34, 0x71, 0x21, 0x99, 0x76, 0xc6,
+	0x32, 0xa1, 0x9d, 0x43, 0x66, 0x86, 0x36, 0xbc, 0x85, 0x89, 0x2f, 0x61, 0x5c, 0xb9, 0xe0, 0x21,
+	0x58, 0x12, 0x56, 0xae, 0xd4, 0xc0, 0xc2, 0xd7, 0x30, 0xb4, 0x03, 0x51, 0x16, 0x6e, 0xda, 0x39,
+	0xff, 0x7f, 0xce, 0x97, 0xc9, 0x7f, 0xc6, 0x72, 0xe3, 0xe9, 0x1d, 0x0d, 0x62, 0x08, 0x39, 0x55,
+	0x19, 0x88, 0x11, 0x12, 0x54, 0x2a, 0x3c, 0x62, 0x3c, 0x42, 0xa9, 0x8f, 0x32, 0xa6, 0x86, 0x44,
+	0xe0, 0x0c, 0xc7, 0xde, 0x58, 0x80, 0x02, 0xfb, 0xcf, 0x5e, 0xbb, 0xb7, 0x6b, 0xf7, 0x52, 0xbf,
+	0x55, 0xc3, 0x09, 0xe3, 0x80, 0xf2, 0x6f, 0x31, 0xd0, 0x72, 0x42, 0x90, 0x09, 0x48, 0x14, 0x60,
+	0x49, 0x51, 0xea, 0x07, 0x54, 0x61, 0x1f, 0x85, 0xc0, 0xb8, 0xf6, 0x9b, 0x85, 0x3f, 0xc8, 0x2b,
+	0x54, 0x14, 0xda, 0xaa, 0x47, 0x10, 0x41, 0xa1, 0x6f, 0x4e, 0x85, 0xfa, 0xff, 0xa1, 0x64, 0x55,
+	0xfb, 0x34, 0xc3, 0x82, 0x5c, 0xef, 0x2e, 0x67, 0x9f, 0x5b, 0x35, 0x42, 0x63, 0x1a, 0x61, 0x05,
+	0x62, 0x80, 0x09, 0x11, 0x54, 0xca, 0x86, 0xd9, 0x31, 0xbb, 0xbf, 0x7a, 0x8d, 0xe5, 0xcc, 0xad,
+	0x6b, 0xee, 0x49, 0xe1, 0x5c, 0x2a, 0xc1, 0x78, 0xd4, 0xaf, 0xee, 0x46, 0xb4, 0x6e, 0x5f, 0x58,
+	0xb5, 0x14, 0xc7, 0x8c, 0x7c, 0xc3, 0x94, 0x72, 0xcc, 0xbf, 0xe5, 0xcc, 0xfd, 0xab, 0x31, 0x57,
+	0xdb, 0x9e, 0x3d, 0x5e, 0xba, 0xa7, 0xdb, 0x43, 0xab, 0x82, 0x13, 0x98, 0x70, 0xd5, 0x28, 0x77,
+	0xca, 0xdd, 0xdf, 0x87, 0x4d, 0x4f, 0x13, 0x36, 0x69, 0x78, 0x3a, 0x0d, 0xef, 0x14, 0x18, 0xef,
+	0x1d, 0xcf, 0x5f, 0xdb, 0xc6, 0xd3, 0x5b, 0xbb, 0x1b, 0x31, 0x35, 0x9c, 0x04, 0x5e, 0x08, 0x89,
+	0x4e, 0x43, 0xff, 0x5c, 0x49, 0x46, 0x48, 0x4d, 0xc7, 0x54, 0xe6, 0x03, 0xf2, 0xf1, 0xe3, 0xf9,
+	0xc0, 0xec, 0x6b, 0x7e, 0xef, 0x76, 0xbe, 0x72, 0xcc, 0xc5, 0xca, 0x31, 0xdf, 0x57, 0x8e, 0x79,
+	0xbf, 0x76, 0x8c, 0xc5, 0xda, 0x31, 0x5e, 0xd6, 0x8e, 0x71, 0x73, 0xf6, 0x05, 0xb8, 0x59, 0x5e,
+	0x0c, 0x30, 0x66, 0x3c, 0x44, 0xdb, 0x45, 0xba, 0xdb, 0xc5, 0xff, 0xf4, 0x10, 0x82, 0x4a, 0x1e,
+	0xfe, 0xd1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x73, 0xf0, 0x9f, 0x2f, 0x02, 0x00, 0x00,
+}
+
+func (m *RewardWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWithdrawal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWithdrawal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWithdrawal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovWithdrawal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovWithdrawal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWithdrawal(x uint64) (n int) {
+	return sovWithdrawal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWithdrawal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWithdrawal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWithdrawal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWithdrawal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWithdrawal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWithdrawal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWithdrawal = fmt.Errorf("proto: unexpected end of group")
+)
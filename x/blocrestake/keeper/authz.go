@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+)
+
+// authorizeCreator checks that the creator of msg may act for delegator: it
+// must either be the delegator or hold an x/authz grant from the delegator for
+// msg. Like x/authz does on MsgExec, an accepted grant is then updated or
+// deleted as the authorization asks.
+func (k Keeper) authorizeCreator(ctx context.Context, creator string, delegator sdk.AccAddress, msg sdk.Msg) error {
+	grantee, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if delegator.Equals(sdk.AccAddress(grantee)) {
+		return nil
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	authorization, expiration := k.authzKeeper.GetAuthorization(ctx, grantee, delegator, msgType)
+	if authorization == nil {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s has no %s grant from %s", creator, msgType, delegator)
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrUnauthorized, err.Error())
+	}
+
+	switch {
+	case resp.Delete:
+		err = k.authzKeeper.DeleteGrant(ctx, grantee, delegator, msgType)
+	case resp.Updated != nil:
+		err = k.authzKeeper.SaveGrant(ctx, grantee, delegator, resp.Updated, expiration)
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to update grant")
+	}
+
+	if !resp.Accept {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s grant from %s was not accepted", msgType, delegator)
+	}
+
+	return nil
+}
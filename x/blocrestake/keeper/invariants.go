@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+)
+
+// RegisterInvariants registers all blocrestake invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+}
+
+// AllInvariants runs all blocrestake invariants.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the module account holds no balance and
+// no delegation in x/staking. It has minter, burner and staking permissions,
+// but every message moves funds straight between the delegator and x/staking
+// on the delegator's behalf, so anything left on it once a message finishes
+// was minted, received or delegated by mistake.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+		msg := ""
+		balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if !balance.IsZero() {
+			msg += fmt.Sprintf("\tmodule account %s holds %s\n", moduleAddr, balance)
+		}
+
+		delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, moduleAddr)
+		if err != nil {
+			panic(err)
+		}
+		for _, delegation := range delegations {
+			msg += fmt.Sprintf("\tmodule account %s holds %s shares of %s\n",
+				moduleAddr, delegation.Shares, delegation.ValidatorAddress)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "module account", msg), msg != ""
+	}
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+)
+
+func TestModuleAccountInvariant(t *testing.T) {
+	f := initFixture(t)
+
+	_, broken := keeper.AllInvariants(f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	// funds minted or sent to the module account break it
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1))))
+	msg, broken := keeper.ModuleAccountInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "1ulbt")
+
+	// and so does a delegation made in the module's own name
+	f.bankKeeper.moduleAccounts[types.ModuleName] = sdk.NewCoins()
+	_, broken = keeper.ModuleAccountInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.setShares(authtypes.NewModuleAddress(types.ModuleName), validator, math.LegacyNewDec(1))
+	msg, broken = keeper.ModuleAccountInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, validator.String())
+}
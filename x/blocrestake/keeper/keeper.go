@@ -30,6 +30,7 @@ type Keeper struct {
 	bankKeeper         types.BankKeeper
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
+	authzKeeper        types.AuthzKeeper
 }
 
 func NewKeeper(
@@ -41,6 +42,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 	distributionKeeper types.DistributionKeeper,
+	authzKeeper types.AuthzKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -56,6 +58,7 @@ func NewKeeper(
 		bankKeeper:         bankKeeper,
 		stakingKeeper:      stakingKeeper,
 		distributionKeeper: distributionKeeper,
+		authzKeeper:        authzKeeper,
 		ibcKeeperFn:        ibcKeeperFn,
 		Port:               collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
 		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
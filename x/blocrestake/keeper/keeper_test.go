@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -42,7 +45,7 @@ func TestMsgServerClaimAndRestake(t *testing.T) {
 	sdkCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	bankKeeper := newMockBankKeeper()
-	stakingKeeper := newMockStakingKeeper("ulbt")
+	stakingKeeper := newMockStakingKeeper(bankKeeper, "ulbt")
 	distributionKeeper := newMockDistributionKeeper(bankKeeper)
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
@@ -57,6 +60,7 @@ func TestMsgServerClaimAndRestake(t *testing.T) {
 		bankKeeper,
 		stakingKeeper,
 		distributionKeeper,
+		newMockAuthzKeeper(),
 	)
 	require.NoError(t, k.Params.Set(sdkCtx, types.DefaultParams()))
 
@@ -78,6 +82,7 @@ func TestMsgServerClaimAndRestake(t *testing.T) {
 	require.True(t, beforeBal.Amount.IsZero(), "expected zero balance prior to claim")
 
 	msg := &types.MsgClaimAndRestake{
+		Creator:   delegator.String(),
 		Delegator: delegator.String(),
 		Validator: validator.String(),
 	}
@@ -177,22 +182,56 @@ func (m *mockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, de
 	return sdk.NewCoin(denom, bal.AmountOf(denom))
 }
 
+func (m *mockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	for module, coins := range m.moduleAccounts {
+		if authtypes.NewModuleAddress(module).Equals(addr) {
+			return coins
+		}
+	}
+	return m.accounts[addr.String()]
+}
+
 // -----------------------------------------------------------------------------
 
+// mockUnbondingTime is the unbonding time of the mock staking keeper.
+const mockUnbondingTime = 21 * 24 * time.Hour
+
 type mockStakingKeeper struct {
+	bank         *mockBankKeeper
 	validators   map[string]stakingtypes.Validator
 	delegations  map[string]math.Int
+	shares       map[string]math.LegacyDec
+	unbondings   map[string]stakingtypes.UnbondingDelegation
 	bondDenomStr string
 }
 
-func newMockStakingKeeper(bondDenom string) *mockStakingKeeper {
+func newMockStakingKeeper(bank *mockBankKeeper, bondDenom string) *mockStakingKeeper {
 	return &mockStakingKeeper{
+		bank:         bank,
 		validators:   make(map[string]stakingtypes.Validator),
 		delegations:  make(map[string]math.Int),
+		shares:       make(map[string]math.LegacyDec),
+		unbondings:   make(map[string]stakingtypes.UnbondingDelegation),
 		bondDenomStr: bondDenom,
 	}
 }
 
+func (m *mockStakingKeeper) sharesKey(del sdk.AccAddress, val sdk.ValAddress) string {
+	return del.String() + "|" + val.String()
+}
+
+func (m *mockStakingKeeper) setShares(del sdk.AccAddress, val sdk.ValAddress, shares math.LegacyDec) {
+	m.shares[m.sharesKey(del, val)] = shares
+}
+
+func (m *mockStakingKeeper) GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error) {
+	shares, ok := m.shares[m.sharesKey(delAddr, valAddr)]
+	if !ok {
+		return stakingtypes.Delegation{}, stakingtypes.ErrNoDelegation
+	}
+	return stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), shares), nil
+}
+
 func (m *mockStakingKeeper) addValidator(val stakingtypes.Validator) {
 	m.validators[val.OperatorAddress] = val
 }
@@ -205,7 +244,38 @@ func (m *mockStakingKeeper) GetValidator(ctx context.Context, addr sdk.ValAddres
 	return val, nil
 }
 
+func (m *mockStakingKeeper) GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error) {
+	prefix := delegator.String() + "|"
+	keys := make([]string, 0, len(m.shares))
+	for key := range m.shares {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	delegations := make([]stakingtypes.Delegation, 0, len(keys))
+	for _, key := range keys {
+		delegations = append(delegations, stakingtypes.NewDelegation(delegator.String(), strings.TrimPrefix(key, prefix), m.shares[key]))
+	}
+	return delegations, nil
+}
+
+// Delegate issues one share per token.
 func (m *mockStakingKeeper) Delegate(ctx context.Context, delAddr sdk.AccAddress, amt math.Int, status stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (math.LegacyDec, error) {
+	if subtractAccount {
+		if err := m.bank.SendCoinsFromAccountToModule(ctx, delAddr, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(m.bondDenomStr, amt))); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+
+	valAddr := sdk.MustValAddressFromBech32(validator.OperatorAddress)
+	shares, ok := m.shares[m.sharesKey(delAddr, valAddr)]
+	if !ok {
+		shares = math.LegacyZeroDec()
+	}
+	m.setShares(delAddr, valAddr, shares.Add(math.LegacyNewDecFromInt(amt)))
+
 	key := delAddr.String()
 	current, ok := m.delegations[key]
 	if !ok {
@@ -216,7 +286,75 @@ func (m *mockStakingKeeper) Delegate(ctx context.Context, delAddr sdk.AccAddress
 }
 
 func (m *mockStakingKeeper) Undelegate(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) (time.Time, math.Int, error) {
-	return time.Time{}, math.ZeroInt(), nil
+	key := m.sharesKey(delAddr, valAddr)
+	delShares, ok := m.shares[key]
+	if !ok {
+		return time.Time{}, math.ZeroInt(), stakingtypes.ErrNoDelegation
+	}
+	if shares.GT(delShares) {
+		return time.Time{}, math.ZeroInt(), stakingtypes.ErrNotEnoughDelegationShares
+	}
+
+	val := m.validators[valAddr.String()]
+	val, amount := val.RemoveDelShares(shares)
+	m.validators[valAddr.String()] = val
+	m.shares[key] = delShares.Sub(shares)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	completionTime := sdkCtx.BlockTime().Add(mockUnbondingTime)
+	ubd, ok := m.unbondings[key]
+	if !ok {
+		ubd = stakingtypes.UnbondingDelegation{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}
+	}
+	ubd.Entries = append(ubd.Entries, stakingtypes.NewUnbondingDelegationEntry(sdkCtx.BlockHeight(), completionTime, amount, 0))
+	m.unbondings[key] = ubd
+
+	return completionTime, amount, nil
+}
+
+// BeginRedelegation removes the shares at the source validator's exchange
+// rate and delegates the tokens they are worth to the destination validator.
+func (m *mockStakingKeeper) BeginRedelegation(ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount math.LegacyDec) (time.Time, error) {
+	key := m.sharesKey(delAddr, valSrcAddr)
+	delShares, ok := m.shares[key]
+	if !ok {
+		return time.Time{}, stakingtypes.ErrNoDelegation
+	}
+	if sharesAmount.GT(delShares) {
+		return time.Time{}, stakingtypes.ErrNotEnoughDelegationShares
+	}
+	dst, ok := m.validators[valDstAddr.String()]
+	if !ok {
+		return time.Time{}, stakingtypes.ErrBadRedelegationDst
+	}
+
+	src := m.validators[valSrcAddr.String()]
+	src, amount := src.RemoveDelShares(sharesAmount)
+	m.validators[valSrcAddr.String()] = src
+	m.shares[key] = delShares.Sub(sharesAmount)
+	if _, err := m.Delegate(ctx, delAddr, amount, stakingtypes.Bonded, dst, false); err != nil {
+		return time.Time{}, err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).BlockTime().Add(mockUnbondingTime), nil
+}
+
+func (m *mockStakingKeeper) GetUnbondingDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.UnbondingDelegation, error) {
+	ubd, ok := m.unbondings[m.sharesKey(delAddr, valAddr)]
+	if !ok {
+		return stakingtypes.UnbondingDelegation{}, stakingtypes.ErrNoUnbondingDelegation
+	}
+	return ubd, nil
+}
+
+func (m *mockStakingKeeper) SetUnbondingDelegation(ctx context.Context, ubd stakingtypes.UnbondingDelegation) error {
+	m.unbondings[ubd.DelegatorAddress+"|"+ubd.ValidatorAddress] = ubd
+	return nil
+}
+
+func (m *mockStakingKeeper) RemoveUnbondingDelegation(ctx context.Context, ubd stakingtypes.UnbondingDelegation) error {
+	delete(m.unbondings, ubd.DelegatorAddress+"|"+ubd.ValidatorAddress)
+	return nil
 }
 
 func (m *mockStakingKeeper) BondDenom(ctx context.Context) (string, error) {
@@ -230,14 +368,16 @@ func (m *mockStakingKeeper) delegatedAmount(addr sdk.AccAddress) math.Int {
 // -----------------------------------------------------------------------------
 
 type mockDistributionKeeper struct {
-	bank    *mockBankKeeper
-	rewards map[string]sdk.Coins
+	bank          *mockBankKeeper
+	rewards       map[string]sdk.Coins
+	withdrawAddrs map[string]sdk.AccAddress
 }
 
 func newMockDistributionKeeper(bank *mockBankKeeper) *mockDistributionKeeper {
 	return &mockDistributionKeeper{
-		bank:    bank,
-		rewards: make(map[string]sdk.Coins),
+		bank:          bank,
+		rewards:       make(map[string]sdk.Coins),
+		withdrawAddrs: make(map[string]sdk.AccAddress),
 	}
 }
 
@@ -249,23 +389,63 @@ func (m *mockDistributionKeeper) setRewards(del sdk.AccAddress, val sdk.ValAddre
 	m.rewards[m.rewardKey(del, val)] = coins
 }
 
+func (m *mockDistributionKeeper) setWithdrawAddr(del, withdrawAddr sdk.AccAddress) {
+	m.withdrawAddrs[del.String()] = withdrawAddr
+}
+
 func (m *mockDistributionKeeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	key := m.rewardKey(delAddr, valAddr)
 	coins, ok := m.rewards[key]
 	if !ok {
 		return nil, distributiontypes.ErrNoValidatorDistInfo
 	}
-	if err := m.bank.SendCoinsFromModuleToAccount(ctx, distributiontypes.ModuleName, delAddr, coins); err != nil {
+	withdrawAddr, ok := m.withdrawAddrs[delAddr.String()]
+	if !ok {
+		withdrawAddr = delAddr
+	}
+	if err := m.bank.SendCoinsFromModuleToAccount(ctx, distributiontypes.ModuleName, withdrawAddr, coins); err != nil {
 		return nil, err
 	}
 	delete(m.rewards, key)
 	return coins, nil
 }
 
+// -----------------------------------------------------------------------------
+
+type mockAuthzKeeper struct {
+	grants map[string]authz.Authorization
+}
+
+func newMockAuthzKeeper() *mockAuthzKeeper {
+	return &mockAuthzKeeper{grants: make(map[string]authz.Authorization)}
+}
+
+func (m *mockAuthzKeeper) grantKey(grantee, granter sdk.AccAddress, msgType string) string {
+	return grantee.String() + "|" + granter.String() + "|" + msgType
+}
+
+func (m *mockAuthzKeeper) GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	return m.grants[m.grantKey(grantee, granter, msgType)], nil
+}
+
+func (m *mockAuthzKeeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	m.grants[m.grantKey(grantee, granter, authorization.MsgTypeURL())] = authorization
+	return nil
+}
+
+func (m *mockAuthzKeeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	delete(m.grants, m.grantKey(grantee, granter, msgType))
+	return nil
+}
+
 type fixture struct {
-	ctx          sdk.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	bankKeeper    *mockBankKeeper
+	stakingKeeper *mockStakingKeeper
+	distrKeeper   *mockDistributionKeeper
+	authzKeeper   *mockAuthzKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -277,8 +457,9 @@ func initFixture(t *testing.T) *fixture {
 	sdkCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	bank := newMockBankKeeper()
-	staking := newMockStakingKeeper("ulbt")
+	staking := newMockStakingKeeper(bank, "ulbt")
 	distr := newMockDistributionKeeper(bank)
+	authzKeeper := newMockAuthzKeeper()
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -292,6 +473,7 @@ func initFixture(t *testing.T) *fixture {
 		bank,
 		staking,
 		distr,
+		authzKeeper,
 	)
 
 	if err := k.Params.Set(sdkCtx, types.DefaultParams()); err != nil {
@@ -299,8 +481,12 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          sdkCtx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           sdkCtx,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		bankKeeper:    bank,
+		stakingKeeper: staking,
+		distrKeeper:   distr,
+		authzKeeper:   authzKeeper,
 	}
 }
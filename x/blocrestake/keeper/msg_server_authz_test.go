@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestMsgServerCreatorAuthorization(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	grantee := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	otherValidator := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: otherValidator.String()})
+	f.bankKeeper.accounts[delegator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 10_000))
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.ctx.WithBlockTime(start)
+	delegate := func(ctx sdk.Context, creator sdk.AccAddress, validator sdk.ValAddress, amount uint64) error {
+		_, err := ms.Delegate(ctx, &v2.MsgDelegate{
+			Creator:   creator.String(),
+			Delegator: delegator.String(),
+			Validator: validator.String(),
+			Amount:    sdk.NewCoin("ulbt", math.NewIntFromUint64(amount)),
+		})
+		return err
+	}
+
+	// the delegator acts for itself without a grant
+	require.NoError(t, delegate(ctx, delegator, validator, 100))
+
+	// anyone else needs a grant
+	require.ErrorIs(t, delegate(ctx, grantee, validator, 100), types.ErrUnauthorized)
+	_, err := ms.Undelegate(ctx, &v2.MsgUndelegate{
+		Creator:   grantee.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    sdk.NewInt64Coin("ulbt", 100),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ClaimAndRestake(ctx, &v2.MsgClaimAndRestake{
+		Creator:   grantee.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	msgType := sdk.MsgTypeURL(&v2.MsgDelegate{})
+	maxAmount := sdk.NewInt64Coin("ulbt", 600)
+	require.NoError(t, f.authzKeeper.SaveGrant(ctx, grantee, delegator, &types.RestakeAuthorization{
+		Action:            types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+		AllowedValidators: []string{validator.String()},
+		MaxAmount:         &maxAmount,
+		Period:            time.Hour,
+	}, nil))
+
+	require.NoError(t, delegate(ctx, grantee, validator, 400))
+	require.Equal(t, math.NewInt(500), f.stakingKeeper.delegatedAmount(delegator))
+	authorization, _ := f.authzKeeper.GetAuthorization(ctx, grantee, delegator, msgType)
+	require.Equal(t, &types.RestakeAuthorization{
+		Action:            types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+		AllowedValidators: []string{validator.String()},
+		MaxAmount:         &maxAmount,
+		Period:            time.Hour,
+		PeriodSpent:       math.NewInt(400),
+		PeriodReset:       start.Add(time.Hour),
+	}, authorization)
+
+	// the period limit, the allowed validators and the granted action are enforced
+	require.ErrorIs(t, delegate(ctx, grantee, validator, 300), types.ErrUnauthorized)
+	require.ErrorIs(t, delegate(ctx, grantee, otherValidator, 100), types.ErrUnauthorized)
+	_, err = ms.Undelegate(ctx, &v2.MsgUndelegate{
+		Creator:   grantee.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    sdk.NewInt64Coin("ulbt", 100),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the limit is available again once the period is over, including to the
+	// v1 shim
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	_, err = keeper.NewMsgServerImpl(f.keeper).Delegate(ctx, &types.MsgDelegate{
+		Creator:   grantee.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    300,
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(800), f.stakingKeeper.delegatedAmount(delegator))
+	authorization, _ = f.authzKeeper.GetAuthorization(ctx, grantee, delegator, msgType)
+	require.Equal(t, math.NewInt(300), authorization.(*types.RestakeAuthorization).PeriodSpent)
+	require.Equal(t, start.Add(2*time.Hour), authorization.(*types.RestakeAuthorization).PeriodReset)
+}
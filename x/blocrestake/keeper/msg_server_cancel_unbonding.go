@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+// CancelUnbonding delegates amount of the unbonding delegation entry created
+// at the given height back to its validator. It mirrors the x/staking
+// MsgCancelUnbondingDelegation, which has no keeper method of its own.
+func (s msgServerV2) CancelUnbonding(ctx context.Context, msg *v2.MsgCancelUnbonding) (*v2.MsgCancelUnbondingResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid validator address: %s", err))
+	}
+
+	if err := s.validateBondCoin(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if msg.CreationHeight <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnbondingNotFound, "invalid creation height %d", msg.CreationHeight)
+	}
+
+	if err := s.authorizeCreator(ctx, msg.Creator, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	val, err := s.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, types.ErrValidatorNotFound
+		}
+		return nil, errorsmod.Wrap(err, "failed to fetch validator")
+	}
+
+	// like x/staking, nothing is delegated to a validator that lost all its
+	// tokens or is jailed
+	if val.InvalidExRate() {
+		return nil, stakingtypes.ErrDelegatorShareExRateInvalid
+	}
+	if val.IsJailed() {
+		return nil, stakingtypes.ErrValidatorJailed
+	}
+
+	ubd, err := s.stakingKeeper.GetUnbondingDelegation(ctx, delegator, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoUnbondingDelegation) {
+			return nil, errorsmod.Wrapf(types.ErrUnbondingNotFound, "no unbonding delegation from %s", valAddr)
+		}
+		return nil, errorsmod.Wrap(err, "failed to fetch unbonding delegation")
+	}
+
+	index := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == msg.CreationHeight {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return nil, errorsmod.Wrapf(types.ErrUnbondingNotFound, "no unbonding delegation entry at height %d", msg.CreationHeight)
+	}
+
+	entry := ubd.Entries[index]
+	if entry.Balance.LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"amount %s exceeds the %s unbonding", msg.Amount.Amount, entry.Balance,
+		)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if entry.CompletionTime.Before(sdkCtx.BlockTime()) {
+		return nil, errorsmod.Wrap(types.ErrUnbondingNotFound, "unbonding delegation entry already completed")
+	}
+
+	// the tokens are still in the not bonded pool, not in the delegator's balance
+	if _, err := s.stakingKeeper.Delegate(ctx, delegator, msg.Amount.Amount, stakingtypes.Unbonding, val, false); err != nil {
+		return nil, errorsmod.Wrap(err, "staking delegate failed")
+	}
+
+	if balance := entry.Balance.Sub(msg.Amount.Amount); balance.IsZero() {
+		ubd.RemoveEntry(int64(index))
+	} else {
+		entry.Balance = balance
+		entry.InitialBalance = entry.InitialBalance.Sub(msg.Amount.Amount)
+		ubd.Entries[index] = entry
+	}
+
+	if len(ubd.Entries) == 0 {
+		err = s.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		err = s.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update unbonding delegation")
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+	})
+
+	return &v2.MsgCancelUnbondingResponse{}, nil
+}
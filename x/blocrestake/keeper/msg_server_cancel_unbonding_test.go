@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestMsgServerCancelUnbonding(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+
+	f.stakingKeeper.addValidator(stakingtypes.Validator{
+		OperatorAddress: validator.String(),
+		Tokens:          math.NewInt(1_000),
+		DelegatorShares: math.LegacyNewDec(1_000),
+	})
+	f.stakingKeeper.setShares(delegator, validator, math.LegacyNewDec(1_000))
+	_, err := ms.Undelegate(ctx, &v2.MsgUndelegate{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    sdk.NewInt64Coin("ulbt", 400),
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(blockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	cancel := func(ctx sdk.Context, amount, creationHeight int64) error {
+		_, err := ms.CancelUnbonding(ctx, &v2.MsgCancelUnbonding{
+			Creator:        delegator.String(),
+			Delegator:      delegator.String(),
+			Validator:      validator.String(),
+			Amount:         sdk.NewInt64Coin("ulbt", amount),
+			CreationHeight: creationHeight,
+		})
+		return err
+	}
+
+	// part of the entry is delegated back and the rest keeps unbonding
+	require.NoError(t, cancel(ctx, 150, 10))
+	delegation, err := f.stakingKeeper.GetDelegation(ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(750), delegation.Shares)
+	ubd, err := f.stakingKeeper.GetUnbondingDelegation(ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, math.NewInt(250), ubd.Entries[0].Balance)
+	require.Equal(t, math.NewInt(250), ubd.Entries[0].InitialBalance)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCancelUnbonding, events[0].Type)
+
+	// more than the entry's balance, another height or a completed entry
+	require.ErrorIs(t, cancel(ctx, 251, 10), types.ErrInsufficientFunds)
+	require.ErrorIs(t, cancel(ctx, 1, 11), types.ErrUnbondingNotFound)
+	require.ErrorIs(t, cancel(ctx, 1, 0), types.ErrUnbondingNotFound)
+	require.ErrorIs(t, cancel(ctx.WithBlockTime(blockTime.Add(mockUnbondingTime+time.Second)), 1, 10), types.ErrUnbondingNotFound)
+
+	// anyone else than the delegator needs a grant
+	_, err = ms.CancelUnbonding(ctx, &v2.MsgCancelUnbonding{
+		Creator:        sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20)).String(),
+		Delegator:      delegator.String(),
+		Validator:      validator.String(),
+		Amount:         sdk.NewInt64Coin("ulbt", 1),
+		CreationHeight: 10,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// cancelling the rest removes the unbonding delegation
+	require.NoError(t, cancel(ctx, 250, 10))
+	_, err = f.stakingKeeper.GetUnbondingDelegation(ctx, delegator, validator)
+	require.ErrorIs(t, err, stakingtypes.ErrNoUnbondingDelegation)
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1_000), delegation.Shares)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+// ClaimAndRestakeAll claims the rewards of every delegation of the delegator,
+// then re-delegates them to the validator that paid them or to the target
+// validator. Only the bond denom rewards that reached the delegator's account
+// are restaked; validators that paid none are left out of the response.
+func (s msgServerV2) ClaimAndRestakeAll(ctx context.Context, msg *v2.MsgClaimAndRestakeAll) (*v2.MsgClaimAndRestakeAllResponse, error) {
+	delAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	var target sdk.ValAddress
+	if msg.TargetValidator != "" {
+		target, err = sdk.ValAddressFromBech32(msg.TargetValidator)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid target validator address: %s", err))
+		}
+	}
+
+	if err := s.authorizeCreator(ctx, msg.Creator, delAddr, msg); err != nil {
+		return nil, err
+	}
+
+	if target != nil {
+		if _, err := s.stakingKeeper.GetValidator(ctx, target); err != nil {
+			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+				return nil, types.ErrValidatorNotFound
+			}
+			return nil, errorsmod.Wrap(err, "failed to fetch target validator")
+		}
+	}
+
+	bondDenom, err := s.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to fetch bond denom")
+	}
+
+	delegations, err := s.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to fetch delegations")
+	}
+
+	// all rewards are withdrawn before anything is restaked: delegating to a
+	// validator withdraws the rewards of the delegation to it, which would
+	// then not be restaked
+	res := &v2.MsgClaimAndRestakeAllResponse{TotalRestaked: sdk.NewInt64Coin(bondDenom, 0)}
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid delegation validator address")
+		}
+
+		rewards, received, err := s.withdrawRewards(ctx, delAddr, valAddr, bondDenom)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to withdraw rewards from %s", valAddr)
+		}
+		if received.IsZero() {
+			continue
+		}
+
+		restaked := sdk.NewCoin(bondDenom, received)
+		res.Restakes = append(res.Restakes, v2.ValidatorRestake{
+			Validator: delegation.ValidatorAddress,
+			Rewards:   rewards,
+			Restaked:  restaked,
+		})
+		res.TotalRestaked = res.TotalRestaked.Add(restaked)
+	}
+
+	for _, restake := range res.Restakes {
+		restakeTo := target
+		if restakeTo == nil {
+			// the address was parsed above
+			restakeTo = sdk.MustValAddressFromBech32(restake.Validator)
+		}
+		if err := s.restake(ctx, delAddr, restakeTo, restake.Restaked.Amount); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to restake rewards of %s", restake.Validator)
+		}
+	}
+
+	return res, nil
+}
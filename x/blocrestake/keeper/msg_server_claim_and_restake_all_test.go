@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestMsgServerClaimAndRestakeAll(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validators := make([]sdk.ValAddress, 3)
+	for i := range validators {
+		validators[i] = sdk.ValAddress(bytes.Repeat([]byte{byte(0x2 + i)}, 20))
+		f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validators[i].String()})
+		f.stakingKeeper.setShares(delegator, validators[i], math.LegacyNewDec(1_000))
+	}
+	setRewards := func(rewards ...sdk.Coins) {
+		for i, coins := range rewards {
+			require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distributiontypes.ModuleName, coins))
+			f.distrKeeper.setRewards(delegator, validators[i], coins)
+		}
+	}
+	shares := func(val sdk.ValAddress) math.LegacyDec {
+		delegation, err := f.stakingKeeper.GetDelegation(f.ctx, delegator, val)
+		require.NoError(t, err)
+		return delegation.Shares
+	}
+
+	// the second validator paid nothing and the third nothing of the bond denom
+	setRewards(
+		sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100), sdk.NewInt64Coin("uatom", 5)),
+		sdk.NewCoins(),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 7)),
+	)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := ms.ClaimAndRestakeAll(ctx, &v2.MsgClaimAndRestakeAll{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []v2.ValidatorRestake{
+		{
+			Validator: validators[0].String(),
+			Rewards:   sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100), sdk.NewInt64Coin("uatom", 5)),
+			Restaked:  sdk.NewInt64Coin("ulbt", 100),
+		},
+	}, res.Restakes)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 100), res.TotalRestaked)
+	require.Equal(t, math.LegacyNewDec(1_100), shares(validators[0]))
+	require.Equal(t, math.LegacyNewDec(1_000), shares(validators[2]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 12)), f.bankKeeper.GetAllBalances(ctx, delegator))
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// with a target validator every validator's rewards are restaked to it
+	setRewards(
+		sdk.NewCoins(sdk.NewInt64Coin("ulbt", 50)),
+		sdk.NewCoins(),
+		sdk.NewCoins(sdk.NewInt64Coin("ulbt", 30)),
+	)
+	res, err = ms.ClaimAndRestakeAll(f.ctx, &v2.MsgClaimAndRestakeAll{
+		Creator:         delegator.String(),
+		Delegator:       delegator.String(),
+		TargetValidator: validators[1].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Restakes, 2)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 80), res.TotalRestaked)
+	require.Equal(t, math.LegacyNewDec(1_100), shares(validators[0]))
+	require.Equal(t, math.LegacyNewDec(1_080), shares(validators[1]))
+	require.Equal(t, math.LegacyNewDec(1_000), shares(validators[2]))
+
+	// rewards paid to a separate withdraw address are not the delegator's to
+	// restake, even with a balance of the delegator's own to take them from
+	withdrawAddr := sdk.AccAddress(bytes.Repeat([]byte{0x8}, 20))
+	f.distrKeeper.setWithdrawAddr(delegator, withdrawAddr)
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distributiontypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500))))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, distributiontypes.ModuleName, delegator, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500))))
+	setRewards(
+		sdk.NewCoins(sdk.NewInt64Coin("ulbt", 50)),
+		sdk.NewCoins(),
+		sdk.NewCoins(),
+	)
+	res, err = ms.ClaimAndRestakeAll(f.ctx, &v2.MsgClaimAndRestakeAll{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Restakes)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 0), res.TotalRestaked)
+	require.Equal(t, math.LegacyNewDec(1_100), shares(validators[0]))
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 500), f.bankKeeper.GetBalance(f.ctx, delegator, "ulbt"))
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 50), f.bankKeeper.GetBalance(f.ctx, withdrawAddr, "ulbt"))
+
+	// the target validator must exist
+	_, err = ms.ClaimAndRestakeAll(f.ctx, &v2.MsgClaimAndRestakeAll{
+		Creator:         delegator.String(),
+		Delegator:       delegator.String(),
+		TargetValidator: sdk.ValAddress(bytes.Repeat([]byte{0x9}, 20)).String(),
+	})
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+
+	// and anyone else than the delegator needs a grant
+	_, err = ms.ClaimAndRestakeAll(f.ctx, &v2.MsgClaimAndRestakeAll{
+		Creator:   sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20)).String(),
+		Delegator: delegator.String(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
@@ -2,88 +2,63 @@ package keeper
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
 )
 
+// Delegate is a shim for the v2 Delegate, with amount in the bond denom.
 func (s msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
-	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	amount, err := s.bondCoin(ctx, msg.Amount)
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+		return nil, err
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
-	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid validator address: %s", err))
-	}
-
-	if msg.Amount == 0 {
-		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
-	}
-
-	val, err := s.stakingKeeper.GetValidator(ctx, valAddr)
-	if err != nil {
-		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
-			return nil, types.ErrValidatorNotFound
-		}
-		return nil, errorsmod.Wrap(err, "failed to fetch validator")
-	}
-
-	amount := math.NewIntFromUint64(msg.Amount)
-	if !amount.IsPositive() {
-		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
-	}
-
-	bondDenom, err := s.stakingKeeper.BondDenom(ctx)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to fetch bond denom")
-	}
-
-	coin := sdk.NewCoin(bondDenom, amount)
-	if err := s.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, stakingtypes.BondedPoolName, sdk.NewCoins(coin)); err != nil {
-		return nil, errorsmod.Wrap(err, "bank transfer failed")
-	}
-
-	if _, err := s.stakingKeeper.Delegate(ctx, delegator, amount, stakingtypes.Unbonded, val, true); err != nil {
-		return nil, errorsmod.Wrap(err, "staking delegate failed")
+	if _, err := (msgServerV2{s.Keeper}).Delegate(ctx, &v2.MsgDelegate{
+		Creator:   msg.Creator,
+		Delegator: msg.Delegator,
+		Validator: msg.Validator,
+		Amount:    amount,
+	}); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgDelegateResponse{}, nil
 }
 
+// Undelegate is a shim for the v2 Undelegate, with amount in the bond denom.
 func (s msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
-	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	amount, err := s.bondCoin(ctx, msg.Amount)
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+		return nil, err
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	res, err := (msgServerV2{s.Keeper}).Undelegate(ctx, &v2.MsgUndelegate{
+		Creator:   msg.Creator,
+		Delegator: msg.Delegator,
+		Validator: msg.Validator,
+		Amount:    amount,
+	})
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid validator address: %s", err))
-	}
-
-	if msg.Amount == 0 {
-		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
-	}
-
-	amount := math.NewIntFromUint64(msg.Amount)
-	if !amount.IsPositive() {
-		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+		return nil, err
 	}
 
-	shares := math.LegacyNewDecFromInt(amount)
+	return &types.MsgUndelegateResponse{
+		CompletionTime: res.CompletionTime,
+		Amount:         res.Amount,
+	}, nil
+}
 
-	if _, _, err := s.stakingKeeper.Undelegate(ctx, delegator, valAddr, shares); err != nil {
-		return nil, errorsmod.Wrap(err, "undelegate failed")
+// bondCoin returns a v1 amount as a coin of the bond denom.
+func (k Keeper) bondCoin(ctx context.Context, amount uint64) (sdk.Coin, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to fetch bond denom")
 	}
 
-	// undelegated tokens move to the not bonded pool automatically; nothing else to do here
-	return &types.MsgUndelegateResponse{}, nil
+	return sdk.NewCoin(bondDenom, math.NewIntFromUint64(amount)), nil
 }
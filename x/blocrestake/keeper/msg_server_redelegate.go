@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+// Redelegate moves amount of the delegation to the source validator to the
+// destination validator, converting it to shares at the source validator's
+// exchange rate like Undelegate.
+func (s msgServerV2) Redelegate(ctx context.Context, msg *v2.MsgRedelegate) (*v2.MsgRedelegateResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	srcAddr, err := sdk.ValAddressFromBech32(msg.SrcValidator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid source validator address: %s", err))
+	}
+
+	dstAddr, err := sdk.ValAddressFromBech32(msg.DstValidator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid destination validator address: %s", err))
+	}
+
+	if srcAddr.Equals(dstAddr) {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, "source and destination validators are the same")
+	}
+
+	if err := s.validateBondCoin(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeCreator(ctx, msg.Creator, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.stakingKeeper.GetValidator(ctx, dstAddr); err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, types.ErrValidatorNotFound
+		}
+		return nil, errorsmod.Wrap(err, "failed to fetch destination validator")
+	}
+
+	shares, err := s.unbondShares(ctx, delegator, srcAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := s.stakingKeeper.BeginRedelegation(ctx, delegator, srcAddr, dstAddr, shares)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "redelegate failed")
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegate,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.SrcValidator),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.DstValidator),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &v2.MsgRedelegateResponse{CompletionTime: completionTime}, nil
+}
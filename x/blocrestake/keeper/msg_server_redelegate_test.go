@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestMsgServerRedelegate(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	src := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	dst := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+
+	// the source validator was slashed by 10%
+	f.stakingKeeper.addValidator(stakingtypes.Validator{
+		OperatorAddress: src.String(),
+		Tokens:          math.NewInt(900),
+		DelegatorShares: math.LegacyNewDec(1_000),
+	})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: dst.String()})
+	f.stakingKeeper.setShares(delegator, src, math.LegacyNewDec(1_000))
+	redelegate := func(creator sdk.AccAddress, src, dst sdk.ValAddress, amount int64) (*v2.MsgRedelegateResponse, error) {
+		return ms.Redelegate(ctx, &v2.MsgRedelegate{
+			Creator:      creator.String(),
+			Delegator:    delegator.String(),
+			SrcValidator: src.String(),
+			DstValidator: dst.String(),
+			Amount:       sdk.NewInt64Coin("ulbt", amount),
+		})
+	}
+	shares := func(val sdk.ValAddress) math.LegacyDec {
+		delegation, err := f.stakingKeeper.GetDelegation(ctx, delegator, val)
+		require.NoError(t, err)
+		return delegation.Shares
+	}
+
+	res, err := redelegate(delegator, src, dst, 450)
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(mockUnbondingTime), res.CompletionTime)
+	require.Equal(t, math.LegacyNewDec(500), shares(src))
+	require.Equal(t, math.LegacyNewDec(450), shares(dst))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeRedelegate, events[0].Type)
+
+	// what is left is worth 450 tokens
+	_, err = redelegate(delegator, src, dst, 451)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	// both validators must exist and differ
+	_, err = redelegate(delegator, src, sdk.ValAddress(bytes.Repeat([]byte{0x9}, 20)), 1)
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	_, err = redelegate(delegator, src, src, 1)
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	// and anyone else than the delegator needs a grant
+	_, err = redelegate(sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20)), src, dst, 1)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestMsgServerUndelegateSlashedValidator(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.ctx.WithBlockTime(blockTime)
+
+	// a 10% slash left 1000 shares worth 900 tokens
+	f.stakingKeeper.addValidator(stakingtypes.Validator{
+		OperatorAddress: validator.String(),
+		Tokens:          math.NewInt(900),
+		DelegatorShares: math.LegacyNewDec(1_000),
+	})
+	f.stakingKeeper.setShares(delegator, validator, math.LegacyNewDec(1_000))
+	undelegate := func(amount int64) (*v2.MsgUndelegateResponse, error) {
+		return ms.Undelegate(ctx, &v2.MsgUndelegate{
+			Creator:   delegator.String(),
+			Delegator: delegator.String(),
+			Validator: validator.String(),
+			Amount:    sdk.NewInt64Coin("ulbt", amount),
+		})
+	}
+
+	res, err := undelegate(450)
+	require.NoError(t, err)
+	require.Equal(t, &v2.MsgUndelegateResponse{
+		CompletionTime: blockTime.Add(mockUnbondingTime),
+		Amount:         sdk.NewInt64Coin("ulbt", 450),
+	}, res)
+	delegation, err := f.stakingKeeper.GetDelegation(ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(500), delegation.Shares)
+
+	// what is left is worth 450 tokens
+	_, err = undelegate(451)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	// the v1 shim converts the same way and returns the same response
+	v1res, err := keeper.NewMsgServerImpl(f.keeper).Undelegate(ctx, &types.MsgUndelegate{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    450,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUndelegateResponse{
+		CompletionTime: blockTime.Add(mockUnbondingTime),
+		Amount:         sdk.NewInt64Coin("ulbt", 450),
+	}, v1res)
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, delegator, validator)
+	require.NoError(t, err)
+	require.True(t, delegation.Shares.IsZero())
+
+	// undelegating from a validator the delegator has no delegation to fails
+	otherValidator := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{
+		OperatorAddress: otherValidator.String(),
+		Tokens:          math.NewInt(1_000),
+		DelegatorShares: math.LegacyNewDec(1_000),
+	})
+	_, err = ms.Undelegate(ctx, &v2.MsgUndelegate{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+		Validator: otherValidator.String(),
+		Amount:    sdk.NewInt64Coin("ulbt", 1),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+}
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+type msgServerV2 struct {
+	Keeper
+}
+
+// NewMsgServerV2Impl returns an implementation of the v2 MsgServer interface
+// for the provided Keeper.
+func NewMsgServerV2Impl(keeper Keeper) v2.MsgServer {
+	return &msgServerV2{Keeper: keeper}
+}
+
+var _ v2.MsgServer = msgServerV2{}
+
+func (s msgServerV2) Delegate(ctx context.Context, msg *v2.MsgDelegate) (*v2.MsgDelegateResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid validator address: %s", err))
+	}
+
+	if err := s.validateBondCoin(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeCreator(ctx, msg.Creator, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	val, err := s.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, types.ErrValidatorNotFound
+		}
+		return nil, errorsmod.Wrap(err, "failed to fetch validator")
+	}
+
+	// the staking keeper moves the coins from the delegator's balance
+	if _, err := s.stakingKeeper.Delegate(ctx, delegator, msg.Amount.Amount, stakingtypes.Unbonded, val, true); err != nil {
+		return nil, errorsmod.Wrap(err, "staking delegate failed")
+	}
+
+	return &v2.MsgDelegateResponse{}, nil
+}
+
+func (s msgServerV2) Undelegate(ctx context.Context, msg *v2.MsgUndelegate) (*v2.MsgUndelegateResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid validator address: %s", err))
+	}
+
+	if err := s.validateBondCoin(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeCreator(ctx, msg.Creator, delegator, msg); err != nil {
+		return nil, err
+	}
+
+	shares, err := s.unbondShares(ctx, delegator, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, amount, err := s.stakingKeeper.Undelegate(ctx, delegator, valAddr, shares)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "undelegate failed")
+	}
+
+	// undelegated tokens move to the not bonded pool automatically; nothing else to do here
+	return &v2.MsgUndelegateResponse{
+		CompletionTime: completionTime,
+		Amount:         sdk.NewCoin(msg.Amount.Denom, amount),
+	}, nil
+}
+
+// unbondShares returns the delegation shares worth amount tokens at the
+// validator's exchange rate, which is no longer 1:1 once it has been slashed.
+// Like x/staking, the shares are capped at those of the delegation, which
+// rounding can exceed when amount is the whole delegation.
+func (k Keeper) unbondShares(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (math.LegacyDec, error) {
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return math.LegacyDec{}, types.ErrValidatorNotFound
+		}
+		return math.LegacyDec{}, errorsmod.Wrap(err, "failed to fetch validator")
+	}
+
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoDelegation) {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInsufficientFunds, "no delegation to %s", valAddr)
+		}
+		return math.LegacyDec{}, errorsmod.Wrap(err, "failed to fetch delegation")
+	}
+
+	shares, err := val.SharesFromTokens(amount)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "failed to convert amount to shares")
+	}
+	sharesTruncated, err := val.SharesFromTokensTruncated(amount)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "failed to convert amount to shares")
+	}
+	if sharesTruncated.GT(delegation.Shares) {
+		return math.LegacyDec{}, errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"amount %s exceeds the %s delegated", amount, val.TokensFromShares(delegation.Shares).TruncateInt(),
+		)
+	}
+	if shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	return shares, nil
+}
+
+// ClaimAndRestake claims rewards via the distribution module, then re-delegates the part of them that reached the
+// delegator to the same validator.
+func (s msgServerV2) ClaimAndRestake(ctx context.Context, msg *v2.MsgClaimAndRestake) (*v2.MsgClaimAndRestakeResponse, error) {
+	delAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, "invalid delegator address")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, "invalid validator address")
+	}
+
+	if err := s.authorizeCreator(ctx, msg.Creator, delAddr, msg); err != nil {
+		return nil, err
+	}
+
+	// 1. Ensure validator exists
+	if _, err := s.stakingKeeper.GetValidator(ctx, valAddr); err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, types.ErrValidatorNotFound
+		}
+		return nil, errorsmod.Wrap(err, "failed to fetch validator")
+	}
+
+	bondDenom, err := s.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to fetch bond denom")
+	}
+
+	// 2. Withdraw delegator’s pending rewards from the distribution module
+	_, amount, err := s.withdrawRewards(ctx, delAddr, valAddr, bondDenom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to withdraw rewards")
+	}
+	if amount.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "no %s rewards available to restake", bondDenom)
+	}
+
+	// 3. Delegate them back
+	if err := s.restake(ctx, delAddr, valAddr, amount); err != nil {
+		return nil, err
+	}
+
+	return &v2.MsgClaimAndRestakeResponse{}, nil
+}
+
+// withdrawRewards withdraws the rewards of the delegation to the validator. It
+// returns them along with the amount of the bond denom that reached the
+// delegator's account, which is all that may be restaked: the rewards are paid
+// to the delegator's withdraw address, which need not be the delegator.
+func (k Keeper) withdrawRewards(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) (sdk.Coins, math.Int, error) {
+	before := k.bankKeeper.GetBalance(ctx, delegator, bondDenom)
+	rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, delegator, valAddr)
+	if err != nil {
+		return nil, math.Int{}, err
+	}
+	after := k.bankKeeper.GetBalance(ctx, delegator, bondDenom)
+
+	received := after.Amount.Sub(before.Amount)
+	if !received.IsPositive() {
+		return rewards, math.ZeroInt(), nil
+	}
+	return rewards, received, nil
+}
+
+// restake delegates amount of the bond denom from the delegator's balance to
+// the validator.
+func (k Keeper) restake(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) error {
+	// the validator is fetched again for every delegation, which changes it
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return types.ErrValidatorNotFound
+		}
+		return errorsmod.Wrap(err, "failed to fetch validator")
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delegator, amount, stakingtypes.Unbonded, val, true); err != nil {
+		return errorsmod.Wrap(err, "restake delegation failed")
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimAndRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return nil
+}
+
+// validateBondCoin checks that coin is a positive amount of the bond denom.
+func (k Keeper) validateBondCoin(ctx context.Context, coin sdk.Coin) error {
+	if err := coin.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAmount, err.Error())
+	}
+	if !coin.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to fetch bond denom")
+	}
+	if coin.Denom != bondDenom {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "denom %s is not the bond denom %s", coin.Denom, bondDenom)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestMsgServerV2Delegate(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	// 18-decimal amounts do not fit in the uint64 of the v1 messages
+	amount, ok := math.NewIntFromString("5000000000000000000000")
+	require.True(t, ok)
+	f.bankKeeper.accounts[delegator.String()] = sdk.NewCoins(sdk.NewCoin("ulbt", amount), sdk.NewInt64Coin("uatom", 100))
+
+	tests := []struct {
+		desc   string
+		amount sdk.Coin
+		err    error
+	}{
+		{
+			desc:   "not the bond denom",
+			amount: sdk.NewInt64Coin("uatom", 100),
+			err:    types.ErrInvalidAmount,
+		},
+		{
+			desc:   "zero amount",
+			amount: sdk.NewInt64Coin("ulbt", 0),
+			err:    types.ErrInvalidAmount,
+		},
+		{
+			desc:   "invalid denom",
+			amount: sdk.Coin{Denom: "1", Amount: math.OneInt()},
+			err:    types.ErrInvalidAmount,
+		},
+		{
+			desc:   "valid",
+			amount: sdk.NewCoin("ulbt", amount),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ms.Delegate(f.ctx, &v2.MsgDelegate{
+				Creator:   delegator.String(),
+				Delegator: delegator.String(),
+				Validator: validator.String(),
+				Amount:    tc.amount,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.Equal(t, amount, f.stakingKeeper.delegatedAmount(delegator))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), f.bankKeeper.GetAllBalances(f.ctx, delegator))
+
+	// the v1 shim rejects zero amounts the way it did before
+	_, err := keeper.NewMsgServerImpl(f.keeper).Delegate(f.ctx, &types.MsgDelegate{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+}
+
+func TestMsgServerV2ClaimAndRestakeWithdrawAddress(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerV2Impl(f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	withdrawAddr := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.setShares(delegator, validator, math.LegacyNewDec(1_000))
+
+	// the delegator's own balance must not stand in for rewards paid elsewhere
+	f.bankKeeper.accounts[delegator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500))
+	f.distrKeeper.setWithdrawAddr(delegator, withdrawAddr)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, distributiontypes.ModuleName, rewards))
+	f.distrKeeper.setRewards(delegator, validator, rewards)
+
+	_, err := ms.ClaimAndRestake(f.ctx, &v2.MsgClaimAndRestake{
+		Creator:   delegator.String(),
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	delegation, err := f.stakingKeeper.GetDelegation(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1_000), delegation.Shares)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 500), f.bankKeeper.GetBalance(f.ctx, delegator, "ulbt"))
+}
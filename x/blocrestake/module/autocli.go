@@ -4,6 +4,7 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 
 	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
 )
 
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
@@ -21,17 +22,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service:              types.Msg_serviceDesc.ServiceName,
+			// v1 only adds the authority gated UpdateParams; its other
+			// messages are shims for these
+			Service:              v2.Msg_serviceDesc.ServiceName,
 			EnhanceCustomCommand: true, // only required if you want to use the custom command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
-				{
-					RpcMethod: "UpdateParams",
-					Skip:      true, // skipped because authority gated
-				},
 				{
 					RpcMethod: "Delegate",
 					Use:       "delegate [delegator] [validator] [amount]",
-					Short:     "Send a Delegate tx",
+					Short:     "Delegate an amount of the bond denom, e.g. 100000ulbt",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "delegator"},
 						{ProtoField: "validator"},
@@ -41,7 +40,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "Undelegate",
 					Use:       "undelegate [delegator] [validator] [amount]",
-					Short:     "Send an Undelegate tx",
+					Short:     "Undelegate an amount of the bond denom, e.g. 100000ulbt",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "delegator"},
 						{ProtoField: "validator"},
@@ -57,6 +56,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator"},
 					},
 				},
+				{
+					RpcMethod:      "ClaimAndRestakeAll",
+					Use:            "claim-and-restake-all [delegator]",
+					Short:          "Claim the rewards of every delegation and restake them, to --target-validator if set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod: "Redelegate",
+					Use:       "redelegate [delegator] [src-validator] [dst-validator] [amount]",
+					Short:     "Redelegate an amount of the bond denom to another validator, e.g. 100000ulbt",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+						{ProtoField: "src_validator"},
+						{ProtoField: "dst_validator"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "CancelUnbonding",
+					Use:       "cancel-unbonding [delegator] [validator] [amount] [creation-height]",
+					Short:     "Cancel the unbonding of an amount of the unbonding delegation entry created at creation-height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+						{ProtoField: "validator"},
+						{ProtoField: "amount"},
+						{ProtoField: "creation_height"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -37,6 +37,7 @@ type ModuleInputs struct {
 	BankKeeper         types.BankKeeper
 	StakingKeeper      types.StakingKeeper
 	DistributionKeeper types.DistributionKeeper
+	AuthzKeeper        types.AuthzKeeper
 
 	IBCKeeperFn func() *ibckeeper.Keeper `optional:"true"`
 }
@@ -63,6 +64,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.StakingKeeper,
 		in.DistributionKeeper,
+		in.AuthzKeeper,
 	)
 	m := NewAppModule(in.Cdc, k)
 
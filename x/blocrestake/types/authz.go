@@ -0,0 +1,152 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+// gasCostPerValidator is charged for every allowed validator checked by Accept,
+// in line with the x/staking authorization.
+const gasCostPerValidator = uint64(10)
+
+var _ authz.Authorization = &RestakeAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RestakeAuthorization) MsgTypeURL() string {
+	switch a.Action {
+	case RestakeAction_RESTAKE_ACTION_DELEGATE:
+		return sdk.MsgTypeURL(&v2.MsgDelegate{})
+	case RestakeAction_RESTAKE_ACTION_UNDELEGATE:
+		return sdk.MsgTypeURL(&v2.MsgUndelegate{})
+	case RestakeAction_RESTAKE_ACTION_CLAIM_AND_RESTAKE:
+		return sdk.MsgTypeURL(&v2.MsgClaimAndRestake{})
+	case RestakeAction_RESTAKE_ACTION_REDELEGATE:
+		return sdk.MsgTypeURL(&v2.MsgRedelegate{})
+	case RestakeAction_RESTAKE_ACTION_CANCEL_UNBONDING:
+		return sdk.MsgTypeURL(&v2.MsgCancelUnbonding{})
+	default:
+		panic(fmt.Sprintf("unknown restake action %s", a.Action))
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RestakeAuthorization) ValidateBasic() error {
+	switch a.Action {
+	case RestakeAction_RESTAKE_ACTION_DELEGATE,
+		RestakeAction_RESTAKE_ACTION_UNDELEGATE,
+		RestakeAction_RESTAKE_ACTION_CLAIM_AND_RESTAKE,
+		RestakeAction_RESTAKE_ACTION_REDELEGATE,
+		RestakeAction_RESTAKE_ACTION_CANCEL_UNBONDING:
+	default:
+		return errorsmod.Wrapf(authz.ErrUnknownAuthorizationType, "unknown restake action %s", a.Action)
+	}
+
+	seen := make(map[string]bool, len(a.AllowedValidators))
+	for _, validator := range a.AllowedValidators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddress, "invalid allowed validator %s: %s", validator, err)
+		}
+		if seen[validator] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed validator %s", validator)
+		}
+		seen[validator] = true
+	}
+
+	if a.Period < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "negative period %s", a.Period)
+	}
+	if a.MaxAmount != nil {
+		if err := a.MaxAmount.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max amount: %s", err)
+		}
+		if a.periodSpent().GT(a.MaxAmount.Amount) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "period spent %s exceeds max amount %s", a.periodSpent(), a.MaxAmount)
+		}
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. It accepts msg if its validator, the
+// destination validator of a redelegation, is allowed and, for every action
+// but claim-and-restake, if its amount fits in what is left of the current
+// period. The authorization is updated with the amount spent.
+func (a RestakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var (
+		validator string
+		amount    sdk.Coin
+	)
+	switch msg := msg.(type) {
+	case *v2.MsgDelegate:
+		validator, amount = msg.Validator, msg.Amount
+	case *v2.MsgUndelegate:
+		validator, amount = msg.Validator, msg.Amount
+	case *v2.MsgClaimAndRestake:
+		validator = msg.Validator
+	case *v2.MsgRedelegate:
+		validator, amount = msg.DstValidator, msg.Amount
+	case *v2.MsgCancelUnbonding:
+		validator, amount = msg.Validator, msg.Amount
+	default:
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unexpected message %T", msg)
+	}
+	if sdk.MsgTypeURL(msg) != a.MsgTypeURL() {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "authorization is for %s, not %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if len(a.AllowedValidators) > 0 {
+		allowed := false
+		for _, v := range a.AllowedValidators {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerValidator, "restake authorization")
+			if v == validator {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "validator %s is not allowed", validator)
+		}
+	}
+
+	if a.MaxAmount == nil || a.Action == RestakeAction_RESTAKE_ACTION_CLAIM_AND_RESTAKE {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	if amount.Denom != a.MaxAmount.Denom {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount is in %s, not %s", amount.Denom, a.MaxAmount.Denom)
+	}
+
+	blockTime := sdkCtx.BlockTime()
+	spent := a.periodSpent()
+	if a.Period > 0 && !blockTime.Before(a.PeriodReset) {
+		spent = sdkmath.ZeroInt()
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+	left := a.MaxAmount.Amount.Sub(spent)
+	if amount.Amount.GT(left) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"amount %s exceeds the %s left of the authorized %s", amount, left, a.MaxAmount,
+		)
+	}
+	a.PeriodSpent = spent.Add(amount.Amount)
+
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// periodSpent returns PeriodSpent, which is nil in an authorization built
+// without one.
+func (a RestakeAuthorization) periodSpent() sdkmath.Int {
+	if a.PeriodSpent.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return a.PeriodSpent
+}
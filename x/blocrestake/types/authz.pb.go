@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lyfeblocnetwork/blocrestake/v1/authz.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RestakeAction is a delegator action of the module that can be granted to
+// another account.
+type RestakeAction int32
+
+const (
+	// RESTAKE_ACTION_UNSPECIFIED is not a valid action of an authorization.
+	RestakeAction_RESTAKE_ACTION_UNSPECIFIED RestakeAction = 0
+	// RESTAKE_ACTION_DELEGATE grants v2 MsgDelegate.
+	RestakeAction_RESTAKE_ACTION_DELEGATE RestakeAction = 1
+	// RESTAKE_ACTION_UNDELEGATE grants v2 MsgUndelegate.
+	RestakeAction_RESTAKE_ACTION_UNDELEGATE RestakeAction = 2
+	// RESTAKE_ACTION_CLAIM_AND_RESTAKE grants v2 MsgClaimAndRestake.
+	RestakeAction_RESTAKE_ACTION_CLAIM_AND_RESTAKE RestakeAction = 3
+	// RESTAKE_ACTION_REDELEGATE grants v2 MsgRedelegate.
+	RestakeAction_RESTAKE_ACTION_REDELEGATE RestakeAction = 4
+	// RESTAKE_ACTION_CANCEL_UNBONDING grants v2 MsgCancelUnbonding.
+	RestakeAction_RESTAKE_ACTION_CANCEL_UNBONDING RestakeAction = 5
+)
+
+var RestakeAction_name = map[int32]string{
+	0: "RESTAKE_ACTION_UNSPECIFIED",
+	1: "RESTAKE_ACTION_DELEGATE",
+	2: "RESTAKE_ACTION_UNDELEGATE",
+	3: "RESTAKE_ACTION_CLAIM_AND_RESTAKE",
+	4: "RESTAKE_ACTION_REDELEGATE",
+	5: "RESTAKE_ACTION_CANCEL_UNBONDING",
+}
+
+var RestakeAction_value = map[string]int32{
+	"RESTAKE_ACTION_UNSPECIFIED":       0,
+	"RESTAKE_ACTION_DELEGATE":          1,
+	"RESTAKE_ACTION_UNDELEGATE":        2,
+	"RESTAKE_ACTION_CLAIM_AND_RESTAKE": 3,
+	"RESTAKE_ACTION_REDELEGATE":        4,
+	"RESTAKE_ACTION_CANCEL_UNBONDING":  5,
+}
+
+func (x RestakeAction) String() string {
+	return proto.EnumName(RestakeAction_name, int32(x))
+}
+
+func (RestakeAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0612a6d716ae2be6, []int{0}
+}
+
+// RestakeAuthorization is an x/authz authorization that lets the grantee
+// execute one v2 message of the module for the granter, the delegator.
+type RestakeAuthorization struct {
+	// action is the action the grantee may execute.
+	Action RestakeAction `protobuf:"varint,1,opt,name=action,proto3,enum=lyfeblocnetwork.blocrestake.v1.RestakeAction" json:"action,omitempty"`
+	// allowed_validators restricts the action to these validators, the
+	// destination validators for redelegations. An empty list allows every
+	// validator.
+	AllowedValidators []string `protobuf:"bytes,2,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty"`
+	// max_amount is the amount the grantee may delegate, undelegate, redelegate
+	// or cancel the unbonding of per period. It must be of the bond denom; nil
+	// means no limit. It does not apply to claim-and-restake, whose amount is
+	// only known once the rewards are withdrawn.
+	MaxAmount *types.Coin `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// period is the length of a spending period. With a zero period max_amount
+	// is a limit over the lifetime of the grant.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spent is the amount used in the current period.
+	PeriodSpent cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=period_spent,json=periodSpent,proto3,customtype=cosmossdk.io/math.Int" json:"period_spent"`
+	// period_reset is the time at which the current period ends.
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *RestakeAuthorization) Reset()         { *m = RestakeAuthorization{} }
+func (m *RestakeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RestakeAuthorization) ProtoMessage()    {}
+func (*RestakeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612a6d716ae2be6, []int{0}
+}
+func (m *RestakeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakeAuthorization.Merge(m, src)
+}
+func (m *RestakeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakeAuthorization proto.InternalMessageInfo
+
+func (m *RestakeAuthorization) GetAction() RestakeAction {
+	if m != nil {
+		return m.Action
+	}
+	return RestakeAction_RESTAKE_ACTION_UNSPECIFIED
+}
+
+func (m *RestakeAuthorization) GetAllowedValidators() []string {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+func (m *RestakeAuthorization) GetMaxAmount() *types.Coin {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+func (m *RestakeAuthorization) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *RestakeAuthorization) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("lyfeblocnetwork.blocrestake.v1.RestakeAction", RestakeAction_name, RestakeAction_value)
+	proto.RegisterType((*RestakeAuthorization)(nil), "lyfeblocnetwork.blocrestake.v1.RestakeAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("lyfeblocnetwork/blocrestake/v1/authz.proto", fileDescriptor_0612a6d716ae2be6)
+}
+
+var fileDescriptor_0612a6d716ae2be6 = []byte{
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xfb, 0x88, 0xe8, 0x14, 0x50, 0x3a, 0x2a, 0x22, 0x0d, 0xaa, 0x13, 0x0a, 0x8b, 0xa8,
+	0x28, 0x63, 0xb5, 0x6c, 0x10, 0x5d, 0x39, 0x89, 0xa9, 0x2c, 0x8a, 0x5b, 0x39, 0x29, 0x0b, 0x24,
+	0x64, 0x4d, 0xe2, 0x69, 0x32, 0xaa, 0xed, 0x89, 0x3c, 0x93, 0xbe, 0x3e, 0x81, 0x55, 0x97, 0xac,
+	0xf8, 0x06, 0x16, 0xfd, 0x88, 0x8a, 0x55, 0x55, 0x09, 0x09, 0xb1, 0x28, 0xa8, 0x5d, 0xf0, 0x1b,
+	0xc8, 0x9e, 0x71, 0x44, 0xd3, 0x88, 0x8d, 0x75, 0xc7, 0xe7, 0x9e, 0x33, 0x67, 0xce, 0xbd, 0x60,
+	0x35, 0x38, 0xde, 0x23, 0x9d, 0x80, 0x75, 0x23, 0x22, 0x0e, 0x59, 0xbc, 0x6f, 0x24, 0x75, 0x4c,
+	0xb8, 0xc0, 0xfb, 0xc4, 0x38, 0x58, 0x33, 0xf0, 0x50, 0xf4, 0x4f, 0xd0, 0x20, 0x66, 0x82, 0x41,
+	0x7d, 0xac, 0x17, 0xfd, 0xd3, 0x8b, 0x0e, 0xd6, 0x4a, 0x0b, 0x38, 0xa4, 0x11, 0x33, 0xd2, 0xaf,
+	0xa4, 0x94, 0xf4, 0x2e, 0xe3, 0x21, 0xe3, 0x46, 0x07, 0xf3, 0x44, 0xae, 0x43, 0x04, 0x5e, 0x33,
+	0xba, 0x8c, 0x46, 0x0a, 0x5f, 0x92, 0xb8, 0x97, 0x9e, 0x0c, 0x79, 0x50, 0xd0, 0x62, 0x8f, 0xf5,
+	0x98, 0xfc, 0x9f, 0x54, 0x99, 0x60, 0x8f, 0xb1, 0x5e, 0x40, 0x8c, 0xf4, 0xd4, 0x19, 0xee, 0x19,
+	0xfe, 0x30, 0xc6, 0x82, 0xb2, 0x4c, 0xb0, 0x3c, 0x8e, 0x0b, 0x1a, 0x26, 0x0e, 0xc3, 0x81, 0x6c,
+	0x58, 0xf9, 0x32, 0x03, 0x16, 0x5d, 0xe9, 0xd9, 0x1c, 0x8a, 0x3e, 0x8b, 0xe9, 0x49, 0xca, 0x87,
+	0x16, 0xc8, 0xe3, 0x6e, 0x52, 0x15, 0xb5, 0x8a, 0x56, 0x7d, 0xb8, 0x5e, 0x43, 0xff, 0x7f, 0x2e,
+	0xca, 0x54, 0x52, 0x92, 0xab, 0xc8, 0x70, 0x07, 0x40, 0x1c, 0x04, 0xec, 0x90, 0xf8, 0xde, 0x01,
+	0x0e, 0xa8, 0x8f, 0x05, 0x8b, 0x79, 0x71, 0xaa, 0x32, 0x5d, 0x9d, 0xab, 0x3f, 0xbd, 0x3c, 0xab,
+	0x2d, 0xab, 0x47, 0xbe, 0xcf, 0x40, 0xd3, 0xf7, 0x63, 0xc2, 0x79, 0x4b, 0xc4, 0x34, 0xea, 0xb9,
+	0x0b, 0x8a, 0x3c, 0x82, 0x39, 0x7c, 0x05, 0x40, 0x88, 0x8f, 0x3c, 0x1c, 0xb2, 0x61, 0x24, 0x8a,
+	0xd3, 0x15, 0xad, 0x3a, 0xbf, 0xbe, 0x84, 0x94, 0x4c, 0x12, 0x2c, 0x52, 0xc1, 0xa2, 0x06, 0xa3,
+	0x91, 0x3b, 0x17, 0xe2, 0x23, 0x33, 0xed, 0x85, 0x1b, 0x20, 0x3f, 0x20, 0x31, 0x65, 0x7e, 0x71,
+	0x46, 0xb1, 0x64, 0x3a, 0x28, 0x4b, 0x07, 0x35, 0x55, 0x7a, 0xf5, 0x7b, 0xe7, 0x57, 0xe5, 0xdc,
+	0xe7, 0x5f, 0x65, 0xcd, 0x55, 0x14, 0xe8, 0x80, 0xfb, 0xb2, 0xf2, 0xf8, 0x80, 0x44, 0xa2, 0x38,
+	0x5b, 0xd1, 0xaa, 0x73, 0xf5, 0x17, 0x49, 0xdf, 0xcf, 0xab, 0xf2, 0x23, 0x79, 0x3f, 0xf7, 0xf7,
+	0x11, 0x65, 0x46, 0x88, 0x45, 0x1f, 0xd9, 0x91, 0xb8, 0x3c, 0xab, 0x01, 0x65, 0xcc, 0x8e, 0x84,
+	0x3b, 0x2f, 0x05, 0x5a, 0x09, 0x1f, 0x6e, 0x8e, 0xf4, 0x62, 0xc2, 0x89, 0x28, 0xe6, 0x53, 0x4b,
+	0xa5, 0x3b, 0x96, 0xda, 0xd9, 0xc0, 0xa4, 0xa7, 0xd3, 0xc4, 0x93, 0x12, 0x72, 0x13, 0xe2, 0xeb,
+	0x8f, 0xdf, 0xce, 0x6a, 0x2b, 0xea, 0x16, 0xb9, 0x9e, 0xd9, 0xfb, 0x6f, 0x0d, 0xf4, 0xd3, 0x9f,
+	0xaf, 0xab, 0xeb, 0xe3, 0xdb, 0x7d, 0x74, 0x6b, 0xbf, 0x27, 0xed, 0xc1, 0xea, 0x77, 0x0d, 0x3c,
+	0xb8, 0x35, 0x5a, 0xa8, 0x83, 0x92, 0x6b, 0xb5, 0xda, 0xe6, 0x5b, 0xcb, 0x33, 0x1b, 0x6d, 0x7b,
+	0xdb, 0xf1, 0x76, 0x9d, 0xd6, 0x8e, 0xd5, 0xb0, 0xdf, 0xd8, 0x56, 0xb3, 0x90, 0x83, 0x4f, 0xc0,
+	0xe3, 0x31, 0xbc, 0x69, 0x6d, 0x59, 0x9b, 0x66, 0xdb, 0x2a, 0x68, 0x70, 0x19, 0x2c, 0xdd, 0x21,
+	0x8f, 0xe0, 0x29, 0xf8, 0x1c, 0x54, 0xc6, 0xe0, 0xc6, 0x96, 0x69, 0xbf, 0xf3, 0x4c, 0xa7, 0xe9,
+	0x29, 0xa0, 0x30, 0x3d, 0x41, 0xc4, 0xb5, 0x46, 0x22, 0x33, 0xf0, 0x19, 0x28, 0x8f, 0x8b, 0x98,
+	0x4e, 0xc3, 0xda, 0xf2, 0x76, 0x9d, 0xfa, 0xb6, 0xd3, 0xb4, 0x9d, 0xcd, 0xc2, 0x6c, 0x7d, 0xf7,
+	0xfc, 0x5a, 0xd7, 0x2e, 0xae, 0x75, 0xed, 0xf7, 0xb5, 0xae, 0x9d, 0xde, 0xe8, 0xb9, 0x8b, 0x1b,
+	0x3d, 0xf7, 0xe3, 0x46, 0xcf, 0x7d, 0xd8, 0xe8, 0x51, 0xd1, 0x1f, 0x76, 0x50, 0x97, 0x85, 0x46,
+	0x12, 0x58, 0xc0, 0xd8, 0x80, 0x46, 0x5d, 0x23, 0x0b, 0xaf, 0x36, 0x39, 0x3d, 0x71, 0x3c, 0x20,
+	0xbc, 0x93, 0x4f, 0x07, 0xf7, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x24, 0x49, 0x98, 0xf6,
+	0x49, 0x04, 0x00, 0x00,
+}
+
+func (m *RestakeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestakeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestakeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PeriodSpent.Size()
+		i -= size
+		if _, err := m.PeriodSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxAmount != nil {
+		{
+			size, err := m.MaxAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidators[iNdEx])
+			copy(dAtA[i:], m.AllowedValidators[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RestakeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovAuthz(uint64(m.Action))
+	}
+	if len(m.AllowedValidators) > 0 {
+		for _, s := range m.AllowedValidators {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxAmount != nil {
+		l = m.MaxAmount.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = m.PeriodSpent.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RestakeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestakeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestakeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RestakeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxAmount == nil {
+				m.MaxAmount = &types.Coin{}
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
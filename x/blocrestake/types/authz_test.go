@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/blocrestake/types/v2"
+)
+
+func TestRestakeAuthorizationValidateBasic(t *testing.T) {
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	tests := []struct {
+		desc          string
+		authorization types.RestakeAuthorization
+		valid         bool
+	}{
+		{
+			desc: "valid",
+			authorization: types.RestakeAuthorization{
+				Action:            types.RestakeAction_RESTAKE_ACTION_UNDELEGATE,
+				AllowedValidators: []string{validator},
+				MaxAmount:         &sdk.Coin{Denom: "ulbt", Amount: sdkmath.NewInt(100)},
+				Period:            time.Hour,
+			},
+			valid: true,
+		},
+		{
+			desc:          "unspecified action",
+			authorization: types.RestakeAuthorization{},
+		},
+		{
+			desc: "invalid validator",
+			authorization: types.RestakeAuthorization{
+				Action:            types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+				AllowedValidators: []string{"invalid"},
+			},
+		},
+		{
+			desc: "duplicate validator",
+			authorization: types.RestakeAuthorization{
+				Action:            types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+				AllowedValidators: []string{validator, validator},
+			},
+		},
+		{
+			desc: "invalid max amount",
+			authorization: types.RestakeAuthorization{
+				Action:    types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+				MaxAmount: &sdk.Coin{Denom: "ulbt", Amount: sdkmath.NewInt(-1)},
+			},
+		},
+		{
+			desc: "period spent over max amount",
+			authorization: types.RestakeAuthorization{
+				Action:      types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+				MaxAmount:   &sdk.Coin{Denom: "ulbt", Amount: sdkmath.NewInt(100)},
+				PeriodSpent: sdkmath.NewInt(101),
+			},
+		},
+		{
+			desc: "negative period",
+			authorization: types.RestakeAuthorization{
+				Action: types.RestakeAction_RESTAKE_ACTION_DELEGATE,
+				Period: -time.Hour,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRestakeAuthorizationAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	undelegate := func(amount sdk.Coin) *v2.MsgUndelegate {
+		return &v2.MsgUndelegate{Delegator: delegator, Validator: validator, Amount: amount}
+	}
+
+	// without a max amount the authorization is never updated
+	authorization := types.RestakeAuthorization{Action: types.RestakeAction_RESTAKE_ACTION_UNDELEGATE}
+	resp, err := authorization.Accept(ctx, undelegate(sdk.NewInt64Coin("ulbt", 1_000)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Nil(t, resp.Updated)
+
+	// a zero period makes the max amount a lifetime limit, which holds amounts
+	// that do not fit in a uint64
+	maxAmount, ok := sdkmath.NewIntFromString("1000000000000000000000")
+	require.True(t, ok)
+	authorization.MaxAmount = &sdk.Coin{Denom: "ulbt", Amount: maxAmount}
+	resp, err = authorization.Accept(ctx, undelegate(sdk.NewCoin("ulbt", maxAmount)))
+	require.NoError(t, err)
+	require.Equal(t, maxAmount, resp.Updated.(*types.RestakeAuthorization).PeriodSpent)
+	_, err = resp.Updated.Accept(ctx.WithBlockTime(time.Now()), undelegate(sdk.NewInt64Coin("ulbt", 1)))
+	require.Error(t, err)
+
+	// amounts of another denom are rejected
+	_, err = authorization.Accept(ctx, undelegate(sdk.NewInt64Coin("uatom", 1)))
+	require.Error(t, err)
+
+	// the amount limit does not apply to claim-and-restake
+	authorization = types.RestakeAuthorization{
+		Action:    types.RestakeAction_RESTAKE_ACTION_CLAIM_AND_RESTAKE,
+		MaxAmount: &sdk.Coin{Denom: "ulbt", Amount: sdkmath.OneInt()},
+	}
+	resp, err = authorization.Accept(ctx, &v2.MsgClaimAndRestake{Delegator: delegator, Validator: validator})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	// messages of another action are rejected
+	_, err = authorization.Accept(ctx, &v2.MsgDelegate{Delegator: delegator, Validator: validator, Amount: sdk.NewInt64Coin("ulbt", 1)})
+	require.Error(t, err)
+
+	// redelegations are checked against their destination validator
+	authorization = types.RestakeAuthorization{
+		Action:            types.RestakeAction_RESTAKE_ACTION_REDELEGATE,
+		AllowedValidators: []string{validator},
+		MaxAmount:         &sdk.Coin{Denom: "ulbt", Amount: sdkmath.NewInt(100)},
+	}
+	other := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20)).String()
+	resp, err = authorization.Accept(ctx, &v2.MsgRedelegate{
+		Delegator:    delegator,
+		SrcValidator: other,
+		DstValidator: validator,
+		Amount:       sdk.NewInt64Coin("ulbt", 100),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), resp.Updated.(*types.RestakeAuthorization).PeriodSpent)
+	_, err = authorization.Accept(ctx, &v2.MsgRedelegate{
+		Delegator:    delegator,
+		SrcValidator: validator,
+		DstValidator: other,
+		Amount:       sdk.NewInt64Coin("ulbt", 1),
+	})
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
@@ -13,5 +14,8 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgUndelegate{},
 		&MsgClaimAndRestake{},
 	)
+	registrar.RegisterImplementations((*authz.Authorization)(nil),
+		&RestakeAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -15,4 +15,6 @@ var (
 	ErrInvalidAmount        = errors.Register(ModuleName, 1503, "invalid amount")
 	ErrValidatorNotFound    = errors.Register(ModuleName, 1504, "validator not found")
 	ErrInsufficientFunds    = errors.Register(ModuleName, 1505, "insufficient funds")
+	ErrUnauthorized         = errors.Register(ModuleName, 1506, "creator is not authorized to act for the delegator")
+	ErrUnbondingNotFound    = errors.Register(ModuleName, 1507, "unbonding delegation entry not found")
 )
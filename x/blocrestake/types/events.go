@@ -2,7 +2,14 @@ package types
 
 const (
 	EventTypeClaimAndRestake = "claim_and_restake"
-	AttributeKeyDelegator    = "delegator"
-	AttributeKeyValidator    = "validator"
-	AttributeKeyAmount       = "amount"
+	EventTypeRedelegate      = "redelegate"
+	EventTypeCancelUnbonding = "cancel_unbonding"
+
+	AttributeKeyDelegator      = "delegator"
+	AttributeKeyValidator      = "validator"
+	AttributeKeySrcValidator   = "source_validator"
+	AttributeKeyDstValidator   = "destination_validator"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyCompletionTime = "completion_time"
+	AttributeKeyCreationHeight = "creation_height"
 )
@@ -7,23 +7,28 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -301,6 +306,11 @@ func (m *MsgUndelegate) GetAmount() uint64 {
 
 // MsgUndelegateResponse defines the MsgUndelegateResponse message.
 type MsgUndelegateResponse struct {
+	// completion_time is the time at which the undelegated tokens are released.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// amount is the amount undelegated, which may differ from the requested
+	// amount by the rounding of the validator's exchange rate.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
 func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
@@ -336,6 +346,20 @@ func (m *MsgUndelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUndelegateResponse proto.InternalMessageInfo
 
+func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgUndelegateResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 // MsgClaimAndRestake defines the MsgClaimAndRestake message.
 type MsgClaimAndRestake struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -450,42 +474,49 @@ func init() {
 }
 
 var fileDescriptor_ff9f936d88acb724 = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x8f, 0x12, 0x41,
-	0x14, 0x67, 0xe4, 0x44, 0x77, 0xee, 0xcc, 0xc5, 0xf5, 0x4e, 0xb8, 0xcd, 0x65, 0x25, 0x14, 0x4a,
-	0xb8, 0xb0, 0x9b, 0xe3, 0xa2, 0x26, 0xd8, 0x78, 0x68, 0x63, 0x41, 0x62, 0xd6, 0x5c, 0x63, 0x63,
-	0x86, 0xdd, 0x71, 0x6e, 0x73, 0xbb, 0x3b, 0x9b, 0x99, 0x01, 0xa1, 0x53, 0x4b, 0x2b, 0xe3, 0x57,
-	0x30, 0x26, 0x96, 0x14, 0x7e, 0x88, 0x2b, 0x2f, 0x16, 0xc6, 0xca, 0x18, 0x28, 0x68, 0xfc, 0x10,
-	0x66, 0xff, 0x02, 0x8b, 0x11, 0x68, 0x8c, 0x0d, 0x99, 0x79, 0xef, 0xf7, 0x7e, 0x7f, 0xe0, 0x31,
-	0xf0, 0x8e, 0x33, 0x78, 0x89, 0x3b, 0x0e, 0x35, 0x3d, 0x2c, 0x5e, 0x51, 0x76, 0xa6, 0x07, 0x67,
-	0x86, 0xb9, 0x40, 0x67, 0x58, 0xef, 0x1d, 0xea, 0xa2, 0xaf, 0xf9, 0x8c, 0x0a, 0x2a, 0xab, 0x19,
-	0xa0, 0x36, 0x03, 0xd4, 0x7a, 0x87, 0xca, 0x75, 0xe4, 0xda, 0x1e, 0xd5, 0xc3, 0xcf, 0x68, 0x44,
-	0x29, 0x9a, 0x94, 0xbb, 0x94, 0xeb, 0x2e, 0x27, 0x01, 0x95, 0xcb, 0x49, 0xdc, 0xd8, 0x8b, 0x1a,
-	0x2f, 0xc2, 0x9b, 0x1e, 0x5d, 0xe2, 0xd6, 0x0e, 0xa1, 0x84, 0x46, 0xf5, 0xe0, 0x14, 0x57, 0x0f,
-	0x96, 0xb8, 0xf4, 0x11, 0x43, 0x6e, 0x4c, 0x51, 0xf9, 0x06, 0xe0, 0x76, 0x9b, 0x93, 0x13, 0xdf,
-	0x42, 0x02, 0x3f, 0x0d, 0x3b, 0xf2, 0x3d, 0x28, 0xa1, 0xae, 0x38, 0xa5, 0xcc, 0x16, 0x83, 0x12,
-	0x28, 0x83, 0xaa, 0xd4, 0x2a, 0x7d, 0xfd, 0x52, 0xdf, 0x89, 0xb5, 0x8f, 0x2d, 0x8b, 0x61, 0xce,
-	0x9f, 0x09, 0x66, 0x7b, 0xc4, 0x98, 0x42, 0xe5, 0x27, 0xb0, 0x10, 0x71, 0x97, 0x2e, 0x95, 0x41,
-	0x75, 0xb3, 0x71, 0x5b, 0xfb, 0xfb, 0xd7, 0xa0, 0x45, 0x7a, 0x2d, 0xe9, 0xfc, 0xc7, 0xad, 0xdc,
-	0xe7, 0xc9, 0xb0, 0x06, 0x8c, 0x98, 0xa0, 0xf9, 0xf0, 0xed, 0x64, 0x58, 0x9b, 0x52, 0xbf, 0x9b,
-	0x0c, 0x6b, 0xf5, 0x6c, 0xac, 0xfe, 0x5c, 0xb0, 0x4c, 0x88, 0xca, 0x1e, 0x2c, 0x66, 0x4a, 0x06,
-	0xe6, 0x3e, 0xf5, 0x38, 0xae, 0x7c, 0x04, 0x70, 0xb3, 0xcd, 0xc9, 0x63, 0xec, 0x60, 0x82, 0x04,
-	0x96, 0x1b, 0xf0, 0x8a, 0xc9, 0x30, 0x12, 0x94, 0x2d, 0x4d, 0x9b, 0x00, 0xe5, 0x7d, 0x28, 0x59,
-	0xd1, 0x3c, 0x65, 0x61, 0x5c, 0xc9, 0x98, 0x16, 0x82, 0x6e, 0x0f, 0x39, 0xb6, 0x15, 0x76, 0xf3,
-	0x51, 0x37, 0x2d, 0xc8, 0x37, 0x61, 0x01, 0xb9, 0xb4, 0xeb, 0x89, 0xd2, 0x46, 0x19, 0x54, 0x37,
-	0x8c, 0xf8, 0xd6, 0xdc, 0x0a, 0x42, 0x27, 0x0a, 0x95, 0x5d, 0x78, 0x63, 0xc6, 0x64, 0x6a, 0xfe,
-	0x13, 0x80, 0xd7, 0x82, 0x60, 0x9e, 0xf5, 0x7f, 0xdb, 0x2f, 0xc2, 0xdd, 0x39, 0x9b, 0x69, 0x80,
-	0x0f, 0x00, 0xca, 0x6d, 0x4e, 0x1e, 0x39, 0xc8, 0x76, 0x8f, 0x3d, 0xcb, 0x88, 0x7e, 0xbf, 0x7f,
-	0x9d, 0x22, 0xe3, 0x76, 0x1f, 0x2a, 0x8b, 0x9e, 0x12, 0xcb, 0x8d, 0x5f, 0x79, 0x98, 0x6f, 0x73,
-	0x22, 0xf7, 0xe1, 0xd6, 0xdc, 0x1f, 0x45, 0x5f, 0xb6, 0xe0, 0x99, 0x0d, 0x54, 0xee, 0xaf, 0x39,
-	0x90, 0x38, 0x90, 0x1d, 0x78, 0x35, 0x5d, 0xd7, 0x83, 0x15, 0x48, 0x12, 0xb0, 0x72, 0xb4, 0x06,
-	0x38, 0x55, 0x63, 0x10, 0xce, 0xec, 0x57, 0x7d, 0x15, 0xd3, 0x29, 0x5c, 0xb9, 0xbb, 0x16, 0x3c,
-	0xd5, 0x7c, 0x03, 0xe0, 0xf6, 0xc2, 0x4e, 0xac, 0x40, 0x95, 0x99, 0x51, 0x9a, 0xeb, 0xcf, 0x24,
-	0x1e, 0x94, 0xcb, 0xaf, 0x83, 0x47, 0xa8, 0x75, 0x72, 0x3e, 0x52, 0xc1, 0xc5, 0x48, 0x05, 0x3f,
-	0x47, 0x2a, 0x78, 0x3f, 0x56, 0x73, 0x17, 0x63, 0x35, 0xf7, 0x7d, 0xac, 0xe6, 0x9e, 0x3f, 0x20,
-	0xb6, 0x38, 0xed, 0x76, 0x34, 0x93, 0xba, 0x7a, 0x20, 0xe3, 0x50, 0xea, 0xdb, 0x9e, 0xa9, 0x27,
-	0x92, 0xf5, 0x3f, 0xbf, 0x4d, 0x62, 0xe0, 0x63, 0xde, 0x29, 0x84, 0x2f, 0xee, 0xd1, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x3e, 0x35, 0x9c, 0x55, 0x46, 0x06, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xde, 0xf9, 0xc1, 0x0f, 0xdd, 0x01, 0x24, 0x56, 0x90, 0xa5, 0x21, 0x85, 0xec, 0x41, 0x09,
+	0x64, 0x3b, 0x61, 0x89, 0x9a, 0xa0, 0x07, 0x59, 0xbc, 0x78, 0xd8, 0xc4, 0x54, 0xb9, 0x78, 0x21,
+	0xd3, 0x76, 0x18, 0x1a, 0xda, 0x4e, 0xd3, 0x99, 0x45, 0xb8, 0xa9, 0x47, 0x4f, 0xc4, 0x7f, 0xc1,
+	0x98, 0xe8, 0x8d, 0x83, 0x7f, 0x04, 0x47, 0xe2, 0xc1, 0x78, 0x52, 0x03, 0x07, 0x2e, 0xfe, 0x11,
+	0x66, 0x3a, 0xd3, 0x2e, 0x5b, 0x8c, 0xec, 0x5e, 0x8c, 0x97, 0xcd, 0xcc, 0x7b, 0xdf, 0x7b, 0xef,
+	0xfb, 0x66, 0xbf, 0x57, 0x78, 0x3b, 0xdc, 0xdf, 0x22, 0x6e, 0xc8, 0xbc, 0x98, 0x88, 0x17, 0x2c,
+	0xdd, 0x41, 0xf2, 0x9c, 0x12, 0x2e, 0xf0, 0x0e, 0x41, 0xbb, 0xcb, 0x48, 0xec, 0xd9, 0x49, 0xca,
+	0x04, 0x33, 0xac, 0x12, 0xd0, 0x3e, 0x07, 0xb4, 0x77, 0x97, 0xcd, 0xeb, 0x38, 0x0a, 0x62, 0x86,
+	0xb2, 0x5f, 0x55, 0x62, 0x5a, 0x1e, 0xe3, 0x11, 0xe3, 0xc8, 0xc5, 0x5c, 0xf6, 0x72, 0x89, 0xc0,
+	0xcb, 0xc8, 0x63, 0x41, 0xac, 0xf3, 0xd3, 0x3a, 0x1f, 0x71, 0x2a, 0x47, 0x45, 0x9c, 0xea, 0xc4,
+	0x8c, 0x4a, 0x6c, 0x66, 0x37, 0xa4, 0x2e, 0x3a, 0x35, 0x49, 0x19, 0x65, 0x2a, 0x2e, 0x4f, 0x3a,
+	0x3a, 0x47, 0x19, 0xa3, 0x21, 0x41, 0xd9, 0xcd, 0xed, 0x6c, 0x21, 0x11, 0x44, 0x92, 0x5a, 0x94,
+	0x68, 0xc0, 0xd2, 0x25, 0x32, 0x13, 0x9c, 0xe2, 0x48, 0xcf, 0xa8, 0x7f, 0x01, 0x70, 0xa2, 0xcd,
+	0xe9, 0x46, 0xe2, 0x63, 0x41, 0x9e, 0x64, 0x19, 0xe3, 0x2e, 0xac, 0xe2, 0x8e, 0xd8, 0x66, 0x69,
+	0x20, 0xf6, 0x6b, 0x60, 0x1e, 0x2c, 0x54, 0x5b, 0xb5, 0xcf, 0x9f, 0x1a, 0x93, 0x9a, 0xdc, 0x9a,
+	0xef, 0xa7, 0x84, 0xf3, 0xa7, 0x22, 0x0d, 0x62, 0xea, 0x74, 0xa1, 0xc6, 0x63, 0x38, 0xa2, 0x7a,
+	0xd7, 0xfe, 0x9b, 0x07, 0x0b, 0xa3, 0xcd, 0x5b, 0xf6, 0x9f, 0xdf, 0xd1, 0x56, 0xf3, 0x5a, 0xd5,
+	0xa3, 0x6f, 0x73, 0x95, 0x0f, 0x67, 0x87, 0x8b, 0xc0, 0xd1, 0x0d, 0x56, 0x1f, 0xbe, 0x3e, 0x3b,
+	0x5c, 0xec, 0xb6, 0x7e, 0x73, 0x76, 0xb8, 0xd8, 0x28, 0xcb, 0xda, 0xeb, 0x11, 0x56, 0x12, 0x51,
+	0x9f, 0x81, 0xd3, 0xa5, 0x90, 0x43, 0x78, 0xc2, 0x62, 0x4e, 0xea, 0xef, 0x00, 0x1c, 0x6d, 0x73,
+	0xfa, 0x88, 0x84, 0x84, 0x62, 0x41, 0x8c, 0x26, 0xbc, 0xe2, 0xa5, 0x04, 0x0b, 0x96, 0x5e, 0xaa,
+	0x36, 0x07, 0x1a, 0xb3, 0xb0, 0xea, 0xab, 0x7a, 0x96, 0x66, 0x72, 0xab, 0x4e, 0x37, 0x20, 0xb3,
+	0xbb, 0x38, 0x0c, 0xfc, 0x2c, 0x3b, 0xa4, 0xb2, 0x45, 0xc0, 0xb8, 0x09, 0x47, 0x70, 0xc4, 0x3a,
+	0xb1, 0xa8, 0x0d, 0xcf, 0x83, 0x85, 0x61, 0x47, 0xdf, 0x56, 0xc7, 0xa4, 0xe8, 0x7c, 0x42, 0x7d,
+	0x0a, 0xde, 0x38, 0x47, 0xb2, 0x20, 0xff, 0x1e, 0xc0, 0x71, 0x29, 0x2c, 0xf6, 0xff, 0x6d, 0xfa,
+	0x1f, 0x01, 0x9c, 0xea, 0xe1, 0x99, 0x2b, 0x30, 0x1c, 0x38, 0xe1, 0xb1, 0x28, 0x09, 0x89, 0x08,
+	0x58, 0xbc, 0x29, 0xdd, 0x9b, 0xf1, 0x1e, 0x6d, 0x9a, 0xb6, 0xb2, 0xb6, 0x9d, 0x5b, 0xdb, 0x7e,
+	0x96, 0x5b, 0xbb, 0x35, 0x2e, 0x3d, 0x72, 0xf0, 0x7d, 0x0e, 0x28, 0x9f, 0x5c, 0xeb, 0x76, 0x90,
+	0x18, 0xe3, 0x41, 0xc1, 0x49, 0x59, 0x6f, 0xc6, 0xd6, 0xfa, 0xe5, 0x3e, 0xda, 0x7a, 0x1f, 0xed,
+	0x75, 0x16, 0xc4, 0x3d, 0x6e, 0x53, 0x35, 0xf5, 0xb7, 0x00, 0x1a, 0x6d, 0x4e, 0xd7, 0x43, 0x1c,
+	0x44, 0x6b, 0xb1, 0xef, 0x28, 0x4b, 0xfd, 0xed, 0x87, 0x2d, 0x3d, 0xe0, 0x2c, 0x34, 0x2f, 0x72,
+	0xca, 0x1f, 0xb1, 0xf9, 0x73, 0x08, 0x0e, 0xb5, 0x39, 0x35, 0xf6, 0xe0, 0x58, 0xcf, 0xee, 0xa2,
+	0xcb, 0x76, 0xae, 0xb4, 0x14, 0xe6, 0xbd, 0x01, 0x0b, 0x8a, 0xbf, 0x31, 0x84, 0x57, 0x8b, 0x0d,
+	0x5a, 0xea, 0xa3, 0x49, 0x0e, 0x36, 0x57, 0x06, 0x00, 0x17, 0xd3, 0x52, 0x08, 0xcf, 0x59, 0xbe,
+	0xd1, 0x0f, 0xe9, 0x02, 0x6e, 0xde, 0x19, 0x08, 0x5e, 0xcc, 0x7c, 0x05, 0xe0, 0xc4, 0x05, 0x4f,
+	0xf4, 0xd1, 0xaa, 0x54, 0x63, 0xae, 0x0e, 0x5e, 0x93, 0x73, 0x30, 0xff, 0x7f, 0x29, 0x9d, 0xda,
+	0xda, 0x38, 0x3a, 0xb1, 0xc0, 0xf1, 0x89, 0x05, 0x7e, 0x9c, 0x58, 0xe0, 0xe0, 0xd4, 0xaa, 0x1c,
+	0x9f, 0x5a, 0x95, 0xaf, 0xa7, 0x56, 0xe5, 0xf9, 0x7d, 0x1a, 0x88, 0xed, 0x8e, 0x6b, 0x7b, 0x2c,
+	0x42, 0x72, 0x4c, 0xc8, 0x58, 0x12, 0xc4, 0x1e, 0xca, 0x47, 0x36, 0x7e, 0xff, 0xb9, 0x14, 0xfb,
+	0x09, 0xe1, 0xee, 0x48, 0xb6, 0x69, 0x2b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x07, 0x27,
+	0xed, 0x1a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -504,10 +535,19 @@ type MsgClient interface {
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// Delegate defines the Delegate RPC.
+	//
+	// Deprecated: use lyfeblocnetwork.blocrestake.v2.Msg/Delegate, which it is a
+	// shim for.
 	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
 	// Undelegate defines the Undelegate RPC.
+	//
+	// Deprecated: use lyfeblocnetwork.blocrestake.v2.Msg/Undelegate, which it is a
+	// shim for.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
 	// ClaimAndRestake defines the ClaimAndRestake RPC.
+	//
+	// Deprecated: use lyfeblocnetwork.blocrestake.v2.Msg/ClaimAndRestake, which it is a
+	// shim for.
 	ClaimAndRestake(ctx context.Context, in *MsgClaimAndRestake, opts ...grpc.CallOption) (*MsgClaimAndRestakeResponse, error)
 }
 
@@ -561,10 +601,19 @@ type MsgServer interface {
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// Delegate defines the Delegate RPC.
+	//
+	// Deprecated: use lyfeblocnetwork.blocrestake.v2.Msg/Delegate, which it is a
+	// shim for.
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
 	// Undelegate defines the Undelegate RPC.
+	//
+	// Deprecated: use lyfeblocnetwork.blocrestake.v2.Msg/Undelegate, which it is a
+	// shim for.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
 	// ClaimAndRestake defines the ClaimAndRestake RPC.
+	//
+	// Deprecated: use lyfeblocnetwork.blocrestake.v2.Msg/ClaimAndRestake, which it is a
+	// shim for.
 	ClaimAndRestake(context.Context, *MsgClaimAndRestake) (*MsgClaimAndRestakeResponse, error)
 }
 
@@ -891,6 +940,24 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1059,6 +1126,10 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1672,6 +1743,72 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
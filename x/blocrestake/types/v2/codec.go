@@ -0,0 +1,19 @@
+package v2
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgClaimAndRestake{},
+		&MsgClaimAndRestakeAll{},
+		&MsgRedelegate{},
+		&MsgCancelUnbonding{},
+	)
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
@@ -0,0 +1,23 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+)
+
+// ResetPayoutsDecorator clears the reward payouts recorded by the previous
+// transaction, which are left behind when it failed. It must run first in the
+// ante handler chain.
+type ResetPayoutsDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewResetPayoutsDecorator(k keeper.Keeper) ResetPayoutsDecorator {
+	return ResetPayoutsDecorator{keeper: k}
+}
+
+func (d ResetPayoutsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	d.keeper.ResetPayouts(ctx)
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,31 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+)
+
+// CommitWithdrawalsDecorator queues the reward withdrawals a transaction paid
+// out, as recorded by the keeper's bank send restriction, once the
+// transaction's messages have succeeded, so that they can be auto-restaked at
+// end block. It must run in the post handler chain.
+type CommitWithdrawalsDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewCommitWithdrawalsDecorator(k keeper.Keeper) CommitWithdrawalsDecorator {
+	return CommitWithdrawalsDecorator{keeper: k}
+}
+
+func (d CommitWithdrawalsDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || simulate || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := d.keeper.CommitWithdrawals(ctx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -1,14 +1,24 @@
 package keeper
 
 import (
-    "context"
+	"context"
 
-    sdk "github.com/cosmos/cosmos-sdk/types"
-    stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
 )
 
-// RestakeDelegate delegates the provided portion back to the validator for the delegator.
+// RestakeDelegate delegates the provided portion back to the validator for the delegator
+// and adds the delegated amount to the restake statistics. The restaking hooks run
+// before and after the delegation. Unhealthy validators are refused.
 func (k Keeper) RestakeDelegate(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, portion sdk.Coins) error {
+	return k.restakeDelegate(ctx, delegator, validator, portion, k.Hooks())
+}
+
+// restakeDelegate is RestakeDelegate running the given restaking hooks.
+func (k Keeper) restakeDelegate(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, portion sdk.Coins, hooks types.RestakingHooks) error {
 	if portion.IsZero() {
 		return nil
 	}
@@ -28,7 +38,20 @@ func (k Keeper) RestakeDelegate(ctx sdk.Context, delegator sdk.AccAddress, valid
 		return err
 	}
 
-	_, err = k.stakingKeeper.Delegate(
+	reason, err := k.unhealthyReason(ctx, val)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return errorsmod.Wrapf(types.ErrValidatorUnhealthy, "%s: %s", validator, reason)
+	}
+
+	restaked := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+	if err := hooks.BeforeAutoRestake(ctx, delegator, validator, restaked); err != nil {
+		return err
+	}
+
+	shares, err := k.stakingKeeper.Delegate(
 		contextWithSDK(ctx),
 		delegator,
 		amount,
@@ -36,7 +59,15 @@ func (k Keeper) RestakeDelegate(ctx sdk.Context, delegator sdk.AccAddress, valid
 		val,
 		true,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if err := k.addRestaked(ctx, delegator, validator, amount, shares); err != nil {
+		return err
+	}
+
+	return hooks.AfterAutoRestake(ctx, delegator, validator, restaked)
 }
 
 func contextWithSDK(ctx sdk.Context) context.Context {
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockBudget bounds the auto-restake work done in one block by the
+// max_restakes_per_block and max_restake_gas_per_block parameters. Restakes
+// run on an infinite gas meter so none is ever interrupted half way; the
+// budget is only checked before each restake starts.
+type BlockBudget struct {
+	restakes    uint32
+	maxRestakes uint32
+	maxGas      uint64
+	meter       storetypes.GasMeter
+}
+
+// NewBlockBudget returns a fresh budget for the current block.
+func (k Keeper) NewBlockBudget(ctx sdk.Context) *BlockBudget {
+	params := k.GetParams(ctx)
+	return &BlockBudget{
+		maxRestakes: params.MaxRestakesPerBlock,
+		maxGas:      params.MaxRestakeGasPerBlock,
+		meter:       storetypes.NewInfiniteGasMeter(),
+	}
+}
+
+// Exhausted reports whether the block may not start another restake.
+func (b *BlockBudget) Exhausted() bool {
+	return b.restakes >= b.maxRestakes || b.meter.GasConsumed() >= b.maxGas
+}
+
+// Remaining returns the number of restakes the block may still start.
+func (b *BlockBudget) Remaining() uint32 {
+	if b.Exhausted() {
+		return 0
+	}
+	return b.maxRestakes - b.restakes
+}
+
+// GasConsumed returns the gas spent by the restakes of the block so far.
+func (b *BlockBudget) GasConsumed() uint64 {
+	return b.meter.GasConsumed()
+}
+
+// run charges one restake to the budget and executes fn, metering its gas.
+func (b *BlockBudget) run(ctx sdk.Context, fn func(sdk.Context) error) error {
+	b.restakes++
+	return fn(ctx.WithGasMeter(b.meter))
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// queueWithdrawal commits a reward withdrawal of amount for the delegator as
+// a successful transaction would.
+func queueWithdrawal(t testing.TB, f *fixture, delegator sdk.AccAddress, validator sdk.ValAddress, amount int64) {
+	t.Helper()
+
+	key := delegator.String() + "|" + validator.String()
+	f.stakingKeeper.delegations[key] = sdkmath.NewInt(1)
+	f.distrKeeper.setRewards(delegator, validator, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", amount)))
+
+	// the withdrawal pays the rewards out
+	rewards, err := f.distrKeeper.WithdrawDelegationRewards(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	ctx := txContext(f)
+	payOut(t, f, ctx, delegator, delegator, validator, rewards)
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+}
+
+func testDelegator(i int) sdk.AccAddress {
+	addr := make([]byte, 20)
+	binary.BigEndian.PutUint64(addr[12:], uint64(i)+1)
+	return addr
+}
+
+func collectDeferred(t *testing.T, f *fixture) []restakingv1.RewardWithdrawal {
+	t.Helper()
+
+	var withdrawals []restakingv1.RewardWithdrawal
+	require.NoError(t, f.keeper.IterateDeferredWithdrawals(f.ctx, func(w restakingv1.RewardWithdrawal) bool {
+		withdrawals = append(withdrawals, w)
+		return false
+	}))
+	return withdrawals
+}
+
+func TestProcessWithdrawalsBudget(t *testing.T) {
+	f := initFixture(t)
+
+	validator := sdk.ValAddress(testDelegator(100))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyOneDec()
+	params.MaxRestakesPerBlock = 2
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	restaked := func(i int) sdkmath.Int {
+		return f.stakingKeeper.delegations[testDelegator(i).String()+"|"+validator.String()].SubRaw(1)
+	}
+
+	for i := range 3 {
+		queueWithdrawal(t, f, testDelegator(i), validator, 10)
+	}
+	require.NoError(t, f.keeper.ProcessWithdrawals(f.ctx, f.keeper.NewBlockBudget(f.ctx)))
+	require.Equal(t, sdkmath.NewInt(10), restaked(0))
+	require.Equal(t, sdkmath.NewInt(10), restaked(1))
+	require.True(t, restaked(2).IsZero())
+	require.Len(t, collectDeferred(t, f), 1)
+
+	// the next block handles the deferred withdrawal before its own ones
+	f.cms.Commit()
+	for i := 3; i < 5; i++ {
+		queueWithdrawal(t, f, testDelegator(i), validator, 10)
+	}
+	require.NoError(t, f.keeper.ProcessWithdrawals(f.ctx, f.keeper.NewBlockBudget(f.ctx)))
+	require.Equal(t, sdkmath.NewInt(10), restaked(2))
+	require.Equal(t, sdkmath.NewInt(10), restaked(3))
+	require.True(t, restaked(4).IsZero())
+	require.Equal(t, []restakingv1.RewardWithdrawal{{
+		DelegatorAddress: testDelegator(4).String(),
+		ValidatorAddress: validator.String(),
+		Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 10)),
+	}}, collectDeferred(t, f))
+
+	// a spent gas budget stops the block after the restake that spent it
+	f.cms.Commit()
+	params.MaxRestakeGasPerBlock = 1
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	queueWithdrawal(t, f, testDelegator(5), validator, 10)
+	budget := f.keeper.NewBlockBudget(f.ctx)
+	require.NoError(t, f.keeper.ProcessWithdrawals(f.ctx, budget))
+	require.True(t, budget.Exhausted())
+	require.Positive(t, budget.GasConsumed())
+	require.Equal(t, sdkmath.NewInt(10), restaked(4))
+	require.True(t, restaked(5).IsZero())
+	require.Len(t, collectDeferred(t, f), 1)
+}
+
+// BenchmarkProcessWithdrawals10k measures the end block work for a block in
+// which 10,000 delegators withdrew their rewards, with and without the
+// default per-block budget.
+func BenchmarkProcessWithdrawals10k(b *testing.B) {
+	const withdrawals = 10_000
+
+	for _, tc := range []struct {
+		name        string
+		maxRestakes uint32
+	}{
+		{"unbounded", withdrawals},
+		{"default_budget", types.DefaultMaxRestakesPerBlock},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				f := initFixture(b)
+				validator := sdk.ValAddress(testDelegator(withdrawals))
+				f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+				params := types.DefaultParams()
+				params.MaxRestakesPerBlock = tc.maxRestakes
+				params.MaxRestakeGasPerBlock = ^uint64(0)
+				require.NoError(b, f.keeper.SetParams(f.ctx, params))
+				for j := range withdrawals {
+					queueWithdrawal(b, f, testDelegator(j), validator, 1_000)
+				}
+				budget := f.keeper.NewBlockBudget(f.ctx)
+				b.StartTimer()
+
+				require.NoError(b, f.keeper.ProcessWithdrawals(f.ctx, budget))
+
+				b.StopTimer()
+				b.ReportMetric(float64(budget.GasConsumed()), "gas/block")
+				b.StartTimer()
+			}
+		})
+	}
+}
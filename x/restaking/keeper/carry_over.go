@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// GetCarryOver returns the amount of bond denom held back from the delegator's
+// auto-restakes of rewards paid by validator.
+func (k Keeper) GetCarryOver(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdkmath.Int, error) {
+	return getIntOrZero(k.carryOvers.Get(ctx, collections.Join(delegator, validator)))
+}
+
+// ClaimCarryOver pays the delegator's carry-over of rewards paid by validator
+// back to it, or all of its carry-over when validator is nil, and returns the
+// amount paid.
+func (k Keeper) ClaimCarryOver(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	var validators []sdk.ValAddress
+	total := sdkmath.ZeroInt()
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
+	if err := k.carryOvers.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], amount sdkmath.Int) (bool, error) {
+		if validator != nil && !key.K2().Equals(validator) {
+			return false, nil
+		}
+		validators = append(validators, key.K2())
+		total = total.Add(amount)
+		return false, nil
+	}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	for _, valAddr := range validators {
+		if err := k.carryOvers.Remove(ctx, collections.Join(delegator, valAddr)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	claimed := sdk.NewCoin(bondDenom, total)
+	if total.IsZero() {
+		return claimed, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, sdk.NewCoins(claimed)); err != nil {
+		return sdk.Coin{}, err
+	}
+	return claimed, nil
+}
+
+// settleCarryOver moves funds between the delegator and its carry-over of
+// rewards paid by validator to match records, the restakes resolved for the
+// rewards. The carry-over is restaked along with the records, so it is paid
+// back to the delegator first; ErrCarryOverUnpaid is returned when that fails,
+// leaving the carry-over in place. When nothing is restaked because the two
+// fall short of the min_restake_amount parameter, the bond denom portion of
+// the rewards is held back in the module account instead, unless the
+// delegator no longer has it.
+func (k Keeper) settleCarryOver(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, rewards sdk.Coins, records []restakingv1.RestakeRecord) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	carryOver, err := k.GetCarryOver(ctx, delegator, validator)
+	if err != nil {
+		return err
+	}
+
+	if len(records) > 0 {
+		if carryOver.IsZero() {
+			return nil
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			cacheCtx, types.ModuleName, delegator, sdk.NewCoins(sdk.NewCoin(bondDenom, carryOver)),
+		); err != nil {
+			return errorsmod.Wrap(types.ErrCarryOverUnpaid, err.Error())
+		}
+		write()
+		return k.carryOvers.Remove(ctx, collections.Join(delegator, validator))
+	}
+
+	// only a restake below the minimum is held back; one left out for lack
+	// of a healthy validator to restake to stays liquid
+	amount := restakePortion(rewards, k.ResolveAutoRestakeRatio(ctx, delegator, validator)).AmountOf(bondDenom)
+	if !amount.IsPositive() || amount.Add(carryOver).GTE(k.GetParams(ctx).MinRestakeAmount) {
+		return nil
+	}
+
+	// the rewards were paid out earlier in the block and may have been spent
+	// since
+	held := sdk.NewCoin(bondDenom, amount)
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, delegator, types.ModuleName, sdk.NewCoins(held)); err != nil {
+		ctx.Logger().Error("auto-restake carry-over failed", "err", err, "delegator", delegator.String(), "validator", validator.String())
+		return nil
+	}
+	write()
+
+	carryOver = carryOver.Add(amount)
+	if err := k.carryOvers.Set(ctx, collections.Join(delegator, validator), carryOver); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&restakingv1.EventRestakeCarriedOver{
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    held,
+		CarryOver: sdk.NewCoin(bondDenom, carryOver),
+		Height:    ctx.BlockHeight(),
+	})
+}
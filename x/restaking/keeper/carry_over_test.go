@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestCarryOver(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MinRestakeAmount = sdkmath.NewInt(600)
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// every withdrawal pays 1000ulbt, of which the default ratio restakes 250
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	withdraw := func(height int64) sdk.Context {
+		t.Helper()
+
+		f.bankKeeper.balances[delegator.String()] = f.bankKeeper.balances[delegator.String()].Add(rewards...)
+		ctx := f.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+		res, broken := keeper.AllInvariants(f.keeper)(ctx)
+		require.False(t, broken, res)
+		return ctx
+	}
+	requireCarryOver := func(expected int64) {
+		t.Helper()
+
+		carryOver, err := f.keeper.GetCarryOver(f.ctx, delegator, validator)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(expected), carryOver)
+		require.Equal(t, sdkmath.NewInt(expected), f.bankKeeper.balances[moduleAddr.String()].AmountOf("ulbt"))
+	}
+
+	// restakes below the minimum are held back in the module account
+	ctx := withdraw(1)
+	requireCarryOver(250)
+	require.Equal(t, sdkmath.NewInt(750), f.bankKeeper.balances[delegator.String()].AmountOf("ulbt"))
+	_, delegated := f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()]
+	require.False(t, delegated)
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	require.Equal(t, &restakingv1.EventRestakeCarriedOver{
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    sdk.NewInt64Coin("ulbt", 250),
+		CarryOver: sdk.NewInt64Coin("ulbt", 250),
+		Height:    1,
+	}, msg)
+
+	withdraw(2)
+	requireCarryOver(500)
+
+	// once the minimum is reached the carry-over is restaked with the rewards
+	withdraw(3)
+	requireCarryOver(0)
+	require.Equal(t, sdkmath.NewInt(750), f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()])
+	restaked, err := f.keeper.GetDelegatorRestaked(f.ctx, delegator)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(750), restaked)
+
+	// rewards the delegator has already spent are not carried over
+	ctx = f.ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	f.bankKeeper.balances[delegator.String()] = sdk.NewCoins()
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+	requireCarryOver(0)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestCarryOverPaybackFailure(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MinRestakeAmount = sdkmath.NewInt(400)
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	f.bankKeeper.balances[delegator.String()] = rewards
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx.WithBlockHeight(1), delegator, validator, rewards))
+
+	// the module account can no longer back the carry-over
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins()
+	f.bankKeeper.balances[delegator.String()] = f.bankKeeper.balances[delegator.String()].Add(rewards...)
+	ctx := f.ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+
+	// the restake fails and is queued for retry, leaving the carry-over be
+	carryOver, err := f.keeper.GetCarryOver(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(250), carryOver)
+	_, delegated := f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()]
+	require.False(t, delegated)
+	require.Equal(t, sdkmath.NewInt(1_750), f.bankKeeper.balances[delegator.String()].AmountOf("ulbt"))
+
+	var failed []restakingv1.EventAutoRestakeFailed
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if e, ok := msg.(*restakingv1.EventAutoRestakeFailed); ok {
+			failed = append(failed, *e)
+		}
+	}
+	require.Len(t, failed, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500)), failed[0].Amount)
+	require.Contains(t, failed[0].Error, types.ErrCarryOverUnpaid.Error())
+
+	entry, found := f.keeper.GetRetryEntry(f.ctx, delegator, 0)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500)), entry.Restake.Amount)
+}
+
+func TestCarryOverWithoutHealthyTarget(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	target := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: target.String(), Jailed: true})
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MinRestakeAmount = sdkmath.ZeroInt()
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress:       delegator.String(),
+		DiversificationTargets: []restakingv1.DiversificationTarget{{ValidatorAddress: target.String(), Weight: 1}},
+	}))
+
+	// with every diversification target jailed nothing is restaked, and with
+	// no minimum to reach nothing is carried over either
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	f.bankKeeper.balances[delegator.String()] = rewards
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+
+	carryOver, err := f.keeper.GetCarryOver(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.True(t, carryOver.IsZero())
+	require.True(t, f.bankKeeper.balances[moduleAddr.String()].IsZero())
+	require.Equal(t, rewards, f.bankKeeper.balances[delegator.String()])
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, "lyfeblocnetwork.restaking.v1.EventRestakeCarriedOver", event.Type)
+	}
+}
+
+func TestClaimCarryOver(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	other := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	genesis := types.DefaultGenesis()
+	genesis.CarryOvers = []restakingv1.CarryOver{
+		{DelegatorAddress: delegator.String(), ValidatorAddress: validator.String(), Amount: sdkmath.NewInt(30)},
+		{DelegatorAddress: delegator.String(), ValidatorAddress: other.String(), Amount: sdkmath.NewInt(12)},
+	}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genesis))
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 42))
+
+	res, err := qs.CarryOvers(f.ctx, &restakingv1.QueryCarryOversRequest{DelegatorAddress: delegator.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, genesis.CarryOvers, res.CarryOvers)
+
+	claimed, err := ms.ClaimCarryOver(f.ctx, &restakingv1.MsgClaimCarryOver{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 30), claimed.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 30)), f.bankKeeper.balances[delegator.String()])
+
+	// without a validator all of the delegator's carry-over is claimed
+	claimed, err = ms.ClaimCarryOver(f.ctx, &restakingv1.MsgClaimCarryOver{DelegatorAddress: delegator.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 12), claimed.Amount)
+	require.True(t, f.bankKeeper.balances[moduleAddr.String()].IsZero())
+
+	res, err = qs.CarryOvers(f.ctx, &restakingv1.QueryCarryOversRequest{DelegatorAddress: delegator.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.CarryOvers)
+	msg, broken := keeper.AllInvariants(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+
+	_, err = ms.ClaimCarryOver(f.ctx, &restakingv1.MsgClaimCarryOver{DelegatorAddress: delegator.String()})
+	require.ErrorIs(t, err, types.ErrCarryOverNotFound)
+
+	_, err = ms.ClaimCarryOver(f.ctx, &restakingv1.MsgClaimCarryOver{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: "invalid",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
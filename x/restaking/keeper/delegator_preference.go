@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// GetDelegatorPreference returns the auto-restake preference of a delegator, if any.
+func (k Keeper) GetDelegatorPreference(ctx sdk.Context, delegator sdk.AccAddress) (restakingv1.DelegatorPreference, bool) {
+	pref, err := k.delegatorPrefs.Get(ctx, delegator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return restakingv1.DelegatorPreference{}, false
+		}
+		panic(err)
+	}
+	return pref, true
+}
+
+// SetDelegatorPreference stores a delegator preference after validation. A
+// preference that is not disabled counts the delegator as an active restaker.
+func (k Keeper) SetDelegatorPreference(ctx sdk.Context, pref restakingv1.DelegatorPreference) error {
+	if err := types.ValidateDelegatorPreference(pref); err != nil {
+		return err
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(pref.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	prev, found := k.GetDelegatorPreference(ctx, delegator)
+	if err := k.delegatorPrefs.Set(ctx, delegator, pref); err != nil {
+		return err
+	}
+	return k.countActiveRestaker(ctx, found && !prev.Disabled, !pref.Disabled)
+}
+
+// DeleteDelegatorPreference removes a delegator preference, no longer counting
+// the delegator as an active restaker.
+func (k Keeper) DeleteDelegatorPreference(ctx sdk.Context, delegator sdk.AccAddress) error {
+	prev, found := k.GetDelegatorPreference(ctx, delegator)
+	if err := k.delegatorPrefs.Remove(ctx, delegator); err != nil {
+		return err
+	}
+	return k.countActiveRestaker(ctx, found && !prev.Disabled, false)
+}
+
+// ResolveAutoRestakeRatio returns the ratio applied to the delegator's rewards
+// paid by validator. Resolution order is: the delegator's entry for that
+// validator, the delegator-wide preference, the validator override and finally
+// the global ratio.
+func (k Keeper) ResolveAutoRestakeRatio(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) sdkmath.LegacyDec {
+	ratio, _ := k.resolveAutoRestakeRatio(ctx, delegator, validator)
+	return ratio
+}
+
+// resolveAutoRestakeRatio is ResolveAutoRestakeRatio that also reports where
+// the ratio came from.
+func (k Keeper) resolveAutoRestakeRatio(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdkmath.LegacyDec, restakingv1.RatioSource) {
+	pref, found := k.GetDelegatorPreference(ctx, delegator)
+	if !found {
+		return k.validatorRatio(ctx, validator)
+	}
+
+	valStr := validator.String()
+	for _, vp := range pref.ValidatorPreferences {
+		if vp.ValidatorAddress != valStr {
+			continue
+		}
+		if vp.Disabled {
+			return sdkmath.LegacyZeroDec(), restakingv1.RatioSource_RATIO_SOURCE_DELEGATOR_VALIDATOR_PREFERENCE
+		}
+		return *vp.Ratio, restakingv1.RatioSource_RATIO_SOURCE_DELEGATOR_VALIDATOR_PREFERENCE
+	}
+
+	if pref.Disabled {
+		return sdkmath.LegacyZeroDec(), restakingv1.RatioSource_RATIO_SOURCE_DELEGATOR_PREFERENCE
+	}
+	if pref.Ratio != nil {
+		return *pref.Ratio, restakingv1.RatioSource_RATIO_SOURCE_DELEGATOR_PREFERENCE
+	}
+
+	return k.validatorRatio(ctx, validator)
+}
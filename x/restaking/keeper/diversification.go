@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// restakeSplit is the part of a restake delegated to one validator.
+type restakeSplit struct {
+	validator sdk.ValAddress
+	amount    sdkmath.Int
+}
+
+// splitRestake splits amount across the healthy validators of targets in
+// proportion to their weights. The rounding dust goes to the largest weight,
+// the first one listed on a tie. Unhealthy or unknown targets are left out,
+// so nothing is returned when none of them is healthy.
+func (k Keeper) splitRestake(ctx sdk.Context, targets []restakingv1.DiversificationTarget, amount sdkmath.Int) ([]restakeSplit, error) {
+	var (
+		splits  []restakeSplit
+		weights []uint64
+		total   = sdkmath.ZeroInt()
+	)
+	for _, target := range targets {
+		valAddr, err := sdk.ValAddressFromBech32(target.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		reason, err := k.unhealthyReason(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			continue
+		}
+
+		splits = append(splits, restakeSplit{validator: valAddr})
+		weights = append(weights, target.Weight)
+		total = total.Add(sdkmath.NewIntFromUint64(target.Weight))
+	}
+	if len(splits) == 0 {
+		return nil, nil
+	}
+
+	largest := 0
+	remainder := amount
+	for i, weight := range weights {
+		splits[i].amount = amount.Mul(sdkmath.NewIntFromUint64(weight)).Quo(total)
+		remainder = remainder.Sub(splits[i].amount)
+		if weight > weights[largest] {
+			largest = i
+		}
+	}
+	splits[largest].amount = splits[largest].amount.Add(remainder)
+
+	nonZero := splits[:0]
+	for _, split := range splits {
+		if split.amount.IsPositive() {
+			nonZero = append(nonZero, split)
+		}
+	}
+	return nonZero, nil
+}
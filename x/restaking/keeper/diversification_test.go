@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+)
+
+func TestDiversifiedAutoRestake(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	payer := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	valA := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	valB := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	valC := sdk.ValAddress(bytes.Repeat([]byte{0x5}, 20))
+	for _, val := range []sdk.ValAddress{payer, valA, valB, valC} {
+		f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: val.String()})
+	}
+
+	one := sdkmath.LegacyOneDec()
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: delegator.String(),
+		Ratio:            &one,
+		DiversificationTargets: []restakingv1.DiversificationTarget{
+			{ValidatorAddress: valB.String(), Weight: 2},
+			{ValidatorAddress: valA.String(), Weight: 3},
+			{ValidatorAddress: valC.String(), Weight: 1},
+		},
+	}))
+	delegated := func(val sdk.ValAddress) sdkmath.Int {
+		amt, ok := f.stakingKeeper.delegations[delegator.String()+"|"+val.String()]
+		if !ok {
+			return sdkmath.ZeroInt()
+		}
+		return amt
+	}
+
+	// the rounding dust of one goes to valA, which has the largest weight
+	qs := keeper.NewQueryServer(&f.keeper)
+	preview, err := qs.SplitPreview(f.ctx, &restakingv1.QuerySplitPreviewRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: payer.String(),
+		Rewards:          sdkmath.NewInt(1_000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 1_000), preview.Restaked)
+	require.Equal(t, []restakingv1.RestakeSplit{
+		{ValidatorAddress: valB.String(), Amount: sdk.NewInt64Coin("ulbt", 333)},
+		{ValidatorAddress: valA.String(), Amount: sdk.NewInt64Coin("ulbt", 501)},
+		{ValidatorAddress: valC.String(), Amount: sdk.NewInt64Coin("ulbt", 166)},
+	}, preview.Splits)
+
+	ctx := f.ctx.WithBlockHeight(4)
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, payer, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))))
+	require.True(t, delegated(payer).IsZero())
+	require.Equal(t, sdkmath.NewInt(333), delegated(valB))
+	require.Equal(t, sdkmath.NewInt(501), delegated(valA))
+	require.Equal(t, sdkmath.NewInt(166), delegated(valC))
+
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	for _, record := range history {
+		require.Equal(t, payer.String(), record.DiversifiedFrom)
+		require.Equal(t, restakingv1.RatioSource_RATIO_SOURCE_DELEGATOR_PREFERENCE, record.RatioSource)
+	}
+
+	// a jailed target is left out and its share spread over the others
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: valA.String(), Jailed: true})
+	preview, err = qs.SplitPreview(f.ctx, &restakingv1.QuerySplitPreviewRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: payer.String(),
+		Rewards:          sdkmath.NewInt(10),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []restakingv1.RestakeSplit{
+		{ValidatorAddress: valB.String(), Amount: sdk.NewInt64Coin("ulbt", 7)},
+		{ValidatorAddress: valC.String(), Amount: sdk.NewInt64Coin("ulbt", 3)},
+	}, preview.Splits)
+}
+
+func TestDiversifiedSplitPreviewWithoutTargets(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	// the default ratio of 25% goes back to the paying validator
+	preview, err := qs.SplitPreview(f.ctx, &restakingv1.QuerySplitPreviewRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Rewards:          sdkmath.NewInt(1_000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []restakingv1.RestakeSplit{
+		{ValidatorAddress: validator.String(), Amount: sdk.NewInt64Coin("ulbt", 250)},
+	}, preview.Splits)
+
+	_, err = qs.SplitPreview(f.ctx, &restakingv1.QuerySplitPreviewRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Rewards:          sdkmath.NewInt(-1),
+	})
+	require.Error(t, err)
+}
+
+func TestDiversifiedEpochCompound(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	payer := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	valA := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	valB := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	for _, val := range []sdk.ValAddress{payer, valA, valB} {
+		f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: val.String()})
+	}
+	f.stakingKeeper.delegations[delegator.String()+"|"+payer.String()] = sdkmath.NewInt(1_000)
+	f.distrKeeper.setRewards(delegator, payer, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", 3)))
+
+	params := f.keeper.GetParams(f.ctx)
+	params.EpochIdentifier = "day"
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// equal weights hand the dust to the first target listed
+	one := sdkmath.LegacyOneDec()
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: delegator.String(),
+		Ratio:            &one,
+		DiversificationTargets: []restakingv1.DiversificationTarget{
+			{ValidatorAddress: valA.String(), Weight: 1},
+			{ValidatorAddress: valB.String(), Weight: 1},
+		},
+	}))
+
+	endEpoch(t, f, "day", 1)
+	require.Equal(t, sdkmath.NewInt(1_000), f.stakingKeeper.delegations[delegator.String()+"|"+payer.String()])
+	require.Equal(t, sdkmath.NewInt(2), f.stakingKeeper.delegations[delegator.String()+"|"+valA.String()])
+	require.Equal(t, sdkmath.NewInt(1), f.stakingKeeper.delegations[delegator.String()+"|"+valB.String()])
+}
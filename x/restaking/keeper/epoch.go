@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// EpochMode reports whether rewards are compounded on an epoch schedule rather
+// than as they are withdrawn.
+func (k Keeper) EpochMode(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EpochIdentifier != ""
+}
+
+// StartCompounding starts a pass over the delegations held by delegators with
+// a stored preference, compounding their rewards. A pass still in progress
+// carries on instead, so that none of its delegations are left out.
+func (k Keeper) StartCompounding(ctx sdk.Context) error {
+	has, err := k.compoundCursor.Has(ctx)
+	if err != nil || has {
+		return err
+	}
+	return k.compoundCursor.Set(ctx, collections.Join(sdk.AccAddress{}, sdk.ValAddress{}))
+}
+
+// CompoundDelegations continues the pass started by StartCompounding,
+// compounding one delegation per restake of the block budget. The delegations
+// left once the budget is exhausted are compounded in the next blocks.
+// Failures only affect the delegation they occur on.
+func (k Keeper) CompoundDelegations(ctx sdk.Context, budget *BlockBudget) error {
+	cursor, err := k.compoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	rng := new(collections.Range[sdk.AccAddress]).StartInclusive(cursor.K1())
+	for {
+		delAddr, found, err := k.nextCompounder(ctx, rng)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return k.compoundCursor.Remove(ctx)
+		}
+
+		from := sdk.ValAddress{}
+		if delAddr.Equals(cursor.K1()) {
+			from = cursor.K2()
+		}
+		next, err := k.compoundDelegator(ctx, budget, delAddr, from)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			return k.compoundCursor.Set(ctx, collections.Join(delAddr, next))
+		}
+
+		rng = new(collections.Range[sdk.AccAddress]).StartExclusive(delAddr)
+	}
+}
+
+// nextCompounder returns the first delegator with a stored preference within
+// rng. The iterator is closed before the delegator is compounded, as that
+// writes to the module store.
+func (k Keeper) nextCompounder(ctx sdk.Context, rng *collections.Range[sdk.AccAddress]) (sdk.AccAddress, bool, error) {
+	iter, err := k.delegatorPrefs.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, false, nil
+	}
+	delAddr, err := iter.Key()
+	if err != nil {
+		return nil, false, err
+	}
+	return delAddr, true, nil
+}
+
+// compoundDelegator compounds the delegator's delegations to validators from
+// from onwards, in validator address order, while the budget lasts. It returns
+// the validator to resume at once the budget is exhausted, or nil when every
+// delegation was handled.
+func (k Keeper) compoundDelegator(ctx sdk.Context, budget *BlockBudget, delegator sdk.AccAddress, from sdk.ValAddress) (sdk.ValAddress, error) {
+	withdrawAddr, err := k.distrKeeper.GetDelegatorWithdrawAddr(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+	// rewards paid to a third party are not the delegator's to restake
+	if !withdrawAddr.Equals(delegator) {
+		return nil, nil
+	}
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, math.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]sdk.ValAddress, 0, len(delegations))
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Compare(valAddr, from) >= 0 {
+			validators = append(validators, valAddr)
+		}
+	}
+	slices.SortFunc(validators, func(a, b sdk.ValAddress) int { return bytes.Compare(a, b) })
+
+	for _, valAddr := range validators {
+		if budget.Exhausted() {
+			return valAddr, nil
+		}
+		if err := budget.run(ctx, func(ctx sdk.Context) error {
+			return k.compoundDelegation(ctx, delegator, valAddr)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// compoundDelegation withdraws the delegation's rewards and auto-restakes them
+// as ExecuteAutoRestake does, so a failed restake is queued for retry. Under
+// the skip policy the rewards of an unhealthy validator are not withdrawn and
+// keep accruing in the distribution module.
+func (k Keeper) compoundDelegation(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) error {
+	if k.ResolveAutoRestakeRatio(ctx, delegator, validator).IsZero() {
+		return nil
+	}
+
+	target, policy, err := k.restakeTarget(ctx, delegator, validator)
+	if err != nil {
+		return err
+	}
+	if target == nil && policy != restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(cacheCtx, delegator, validator)
+	if err != nil {
+		return k.autoRestakeFailed(ctx, delegator, validator, nil, err)
+	}
+	write()
+
+	return k.ExecuteAutoRestake(ctx, delegator, validator, rewards)
+}
+
+// EpochHooks wraps the keeper to implement the epochs module hooks.
+type EpochHooks struct {
+	k *Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the hooks to register with the epochs module.
+func (k *Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd starts compounding the opted-in delegations when the
+// governance-chosen epoch ends. They are compounded by the EndBlocker, within
+// the per-block restake budget.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if h.k.GetParams(sdkCtx).EpochIdentifier != epochIdentifier {
+		return nil
+	}
+	return h.k.StartCompounding(sdkCtx)
+}
+
+// BeforeEpochStart is a no-op.
+func (h EpochHooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
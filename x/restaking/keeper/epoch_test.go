@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// endEpoch ends the epoch and runs the compounding pass it starts, if any,
+// within a single block budget.
+func endEpoch(t testing.TB, f *fixture, identifier string, number int64) {
+	t.Helper()
+
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(f.ctx, identifier, number))
+	require.NoError(t, f.keeper.CompoundDelegations(f.ctx, f.keeper.NewBlockBudget(f.ctx)))
+}
+
+func TestEpochHooksCompoundOptedInDelegations(t *testing.T) {
+	f := initFixture(t)
+
+	optedIn := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	passive := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	for _, delAddr := range []sdk.AccAddress{optedIn, passive} {
+		f.stakingKeeper.delegations[delAddr.String()+"|"+validator.String()] = sdkmath.NewInt(1_000)
+		f.distrKeeper.setRewards(delAddr, validator, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", 400)))
+	}
+
+	params := f.keeper.GetParams(f.ctx)
+	params.EpochIdentifier = "day"
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	half := sdkmath.LegacyMustNewDecFromStr("0.5")
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: optedIn.String(),
+		Ratio:            &half,
+	}))
+
+	// withdrawals are left alone in epoch mode
+	ctx := txContext(f)
+	payOut(t, f, ctx, optedIn, optedIn, validator, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 400)))
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+	require.Empty(t, collectWithdrawals(t, f))
+
+	// other epochs are ignored
+	endEpoch(t, f, "hour", 1)
+	require.Equal(t, sdkmath.NewInt(1_000), f.stakingKeeper.delegations[optedIn.String()+"|"+validator.String()])
+
+	endEpoch(t, f, "day", 1)
+	require.Equal(t, sdkmath.NewInt(1_200), f.stakingKeeper.delegations[optedIn.String()+"|"+validator.String()])
+	require.Equal(t, sdkmath.NewInt(1_000), f.stakingKeeper.delegations[passive.String()+"|"+validator.String()])
+}
+
+func TestCompoundDelegationsBudget(t *testing.T) {
+	f := initFixture(t)
+
+	valA := sdk.ValAddress(testDelegator(100))
+	valB := sdk.ValAddress(testDelegator(101))
+	for _, val := range []sdk.ValAddress{valA, valB} {
+		f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: val.String()})
+	}
+
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyOneDec()
+	params.EpochIdentifier = "day"
+	params.MaxRestakesPerBlock = 3
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// two delegators with two delegations each
+	for i := range 2 {
+		require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+			DelegatorAddress: testDelegator(i).String(),
+		}))
+		for _, val := range []sdk.ValAddress{valA, valB} {
+			f.stakingKeeper.delegations[testDelegator(i).String()+"|"+val.String()] = sdkmath.NewInt(1_000)
+			f.distrKeeper.setRewards(testDelegator(i), val, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", 10)))
+		}
+	}
+	compounded := func() int {
+		n := 0
+		for key, amt := range f.stakingKeeper.delegations {
+			if amt.Equal(sdkmath.NewInt(1_010)) {
+				n++
+			} else {
+				require.Equal(t, sdkmath.NewInt(1_000), amt, key)
+			}
+		}
+		return n
+	}
+
+	// the epoch end only starts the pass
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(f.ctx, "day", 1))
+	require.Zero(t, compounded())
+
+	// the first block stops half way through the second delegator
+	require.NoError(t, f.keeper.CompoundDelegations(f.ctx, f.keeper.NewBlockBudget(f.ctx)))
+	require.Equal(t, 3, compounded())
+	require.Equal(t, sdkmath.NewInt(1_000), f.stakingKeeper.delegations[testDelegator(1).String()+"|"+valB.String()])
+
+	// the next one resumes where it stopped and ends the pass
+	budget := f.keeper.NewBlockBudget(f.ctx)
+	require.NoError(t, f.keeper.CompoundDelegations(f.ctx, budget))
+	require.Equal(t, 4, compounded())
+	require.Equal(t, uint32(2), budget.Remaining())
+
+	budget = f.keeper.NewBlockBudget(f.ctx)
+	require.NoError(t, f.keeper.CompoundDelegations(f.ctx, budget))
+	require.Equal(t, uint32(3), budget.Remaining())
+}
+
+func TestCompoundDelegationsQueuesFailedRestakes(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()] = sdkmath.NewInt(1_000)
+	f.distrKeeper.setRewards(delegator, validator, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", 40)))
+
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyOneDec()
+	params.EpochIdentifier = "day"
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: delegator.String(),
+	}))
+
+	// the validator is unknown, so the restake fails; the rewards are
+	// withdrawn and the restake is queued for retry
+	endEpoch(t, f, "day", 1)
+	require.Empty(t, f.distrKeeper.rewards)
+	require.Equal(t, sdkmath.NewInt(1_000), f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()])
+
+	res, err := keeper.NewQueryServer(&f.keeper).RetryEntries(f.ctx, &restakingv1.QueryRetryEntriesRequest{
+		DelegatorAddress: delegator.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40)), res.Entries[0].Restake.Amount)
+}
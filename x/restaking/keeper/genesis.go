@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState restakingv1.GenesisState) error {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, o := range genState.ValidatorOverrides {
+		valAddr, err := sdk.ValAddressFromBech32(o.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.SetValidatorOverride(ctx, valAddr, o.Ratio); err != nil {
+			return err
+		}
+	}
+
+	for _, pref := range genState.DelegatorPreferences {
+		if err := k.SetDelegatorPreference(ctx, pref); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range genState.RestakeHistory {
+		if err := k.AppendRestakeRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	total := sdkmath.ZeroInt()
+	for _, stats := range genState.DelegatorStats {
+		delAddr, err := sdk.AccAddressFromBech32(stats.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.delegatorStats.Set(ctx, delAddr, stats.Restaked); err != nil {
+			return err
+		}
+		total = total.Add(stats.Restaked)
+	}
+	if len(genState.DelegatorStats) > 0 {
+		if err := k.totalRestaked.Set(ctx, total); err != nil {
+			return err
+		}
+	}
+
+	for _, stats := range genState.ValidatorStats {
+		valAddr, err := sdk.ValAddressFromBech32(stats.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.validatorStats.Set(ctx, valAddr, stats.Restaked); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range genState.RetryEntries {
+		delAddr, err := sdk.AccAddressFromBech32(entry.Restake.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.setRetryEntry(ctx, delAddr, entry); err != nil {
+			return err
+		}
+	}
+
+	if err := k.retrySequence.Set(ctx, genState.NextRetryId); err != nil {
+		return err
+	}
+
+	for _, w := range genState.DeferredWithdrawals {
+		if err := k.queueWithdrawal(ctx, w); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range genState.CarryOvers {
+		delAddr, err := sdk.AccAddressFromBech32(c.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(c.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.carryOvers.Set(ctx, collections.Join(delAddr, valAddr), c.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range genState.RestakedShares {
+		delAddr, err := sdk.AccAddressFromBech32(r.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.restakedShares.Set(ctx, collections.Join(delAddr, valAddr), r.Shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*restakingv1.GenesisState, error) {
+	genesis := &restakingv1.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+
+	if err := k.validatorOverrides.Walk(ctx, nil, func(valAddr sdk.ValAddress, ratio sdkmath.LegacyDec) (bool, error) {
+		genesis.ValidatorOverrides = append(genesis.ValidatorOverrides, restakingv1.ValidatorOverride{
+			ValidatorAddress: valAddr.String(),
+			Ratio:            ratio,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.delegatorPrefs.Walk(ctx, nil, func(_ sdk.AccAddress, pref restakingv1.DelegatorPreference) (bool, error) {
+		genesis.DelegatorPreferences = append(genesis.DelegatorPreferences, pref)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.restakeHistory.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], record restakingv1.RestakeRecord) (bool, error) {
+		genesis.RestakeHistory = append(genesis.RestakeHistory, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.delegatorStats.Walk(ctx, nil, func(delAddr sdk.AccAddress, restaked sdkmath.Int) (bool, error) {
+		genesis.DelegatorStats = append(genesis.DelegatorStats, restakingv1.DelegatorRestakeStats{
+			DelegatorAddress: delAddr.String(),
+			Restaked:         restaked,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.validatorStats.Walk(ctx, nil, func(valAddr sdk.ValAddress, restaked sdkmath.Int) (bool, error) {
+		genesis.ValidatorStats = append(genesis.ValidatorStats, restakingv1.ValidatorRestakeStats{
+			ValidatorAddress: valAddr.String(),
+			Restaked:         restaked,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.retryEntries.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], entry restakingv1.RetryEntry) (bool, error) {
+		genesis.RetryEntries = append(genesis.RetryEntries, entry)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	nextRetryID, err := k.retrySequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.NextRetryId = nextRetryID
+
+	if err := k.IterateDeferredWithdrawals(ctx, func(w restakingv1.RewardWithdrawal) bool {
+		genesis.DeferredWithdrawals = append(genesis.DeferredWithdrawals, w)
+		return false
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.carryOvers.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], amount sdkmath.Int) (bool, error) {
+		genesis.CarryOvers = append(genesis.CarryOvers, restakingv1.CarryOver{
+			DelegatorAddress: key.K1().String(),
+			ValidatorAddress: key.K2().String(),
+			Amount:           amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.restakedShares.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], shares sdkmath.LegacyDec) (bool, error) {
+		genesis.RestakedShares = append(genesis.RestakedShares, restakingv1.RestakedShares{
+			DelegatorAddress: key.K1().String(),
+			ValidatorAddress: key.K2().String(),
+			Shares:           shares,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestGenesis(t *testing.T) {
+	half := sdkmath.LegacyMustNewDecFromStr("0.5")
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyMustNewDecFromStr("0.4")
+
+	genesisState := restakingv1.GenesisState{
+		Params: params,
+		ValidatorOverrides: []restakingv1.ValidatorOverride{
+			{ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(), Ratio: half},
+		},
+		DelegatorPreferences: []restakingv1.DelegatorPreference{
+			{DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(), Disabled: true},
+		},
+		RestakeHistory: []restakingv1.RestakeRecord{
+			{
+				DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(),
+				ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(),
+				Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 10)),
+				Ratio:            half,
+				RatioSource:      restakingv1.RatioSource_RATIO_SOURCE_VALIDATOR_OVERRIDE,
+				Height:           3,
+			},
+			{
+				DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(),
+				ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(),
+				Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 20)),
+				Ratio:            half,
+				RatioSource:      restakingv1.RatioSource_RATIO_SOURCE_VALIDATOR_OVERRIDE,
+				Height:           9,
+			},
+		},
+		DelegatorStats: []restakingv1.DelegatorRestakeStats{
+			{DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(), Restaked: sdkmath.NewInt(30)},
+		},
+		ValidatorStats: []restakingv1.ValidatorRestakeStats{
+			{ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(), Restaked: sdkmath.NewInt(30)},
+		},
+		RetryEntries: []restakingv1.RetryEntry{
+			{
+				Id: 4,
+				Restake: restakingv1.RestakeRecord{
+					DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(),
+					ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(),
+					Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 5)),
+					Ratio:            half,
+					RatioSource:      restakingv1.RatioSource_RATIO_SOURCE_VALIDATOR_OVERRIDE,
+					Height:           10,
+				},
+				Status:            restakingv1.RetryStatus_RETRY_STATUS_PENDING,
+				Attempts:          1,
+				NextAttemptHeight: 30,
+				LastError:         "validator does not exist",
+			},
+		},
+		NextRetryId: 5,
+		DeferredWithdrawals: []restakingv1.RewardWithdrawal{
+			{
+				DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20)).String(),
+				ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(),
+				Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 7)),
+			},
+			{
+				DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(),
+				ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(),
+				Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 9)),
+			},
+		},
+		CarryOvers: []restakingv1.CarryOver{
+			{
+				DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(),
+				ValidatorAddress: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(),
+				Amount:           sdkmath.NewInt(3),
+			},
+		},
+	}
+
+	f := initFixture(t)
+	err := f.keeper.InitGenesis(f.ctx, genesisState)
+	require.NoError(t, err)
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	require.Equal(t, genesisState, *got)
+
+	// chain-wide totals are rebuilt from the delegator stats
+	total, err := f.keeper.GetTotalRestaked(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(30), total)
+
+	// and the active restakers from the preferences, the only one of which
+	// is disabled
+	restakers, err := f.keeper.GetActiveRestakers(f.ctx)
+	require.NoError(t, err)
+	require.Zero(t, restakers)
+}
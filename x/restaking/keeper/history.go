@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// AppendRestakeRecord adds a record to its delegator's history, pruning the
+// oldest record once the history holds MaxRestakeHistory records.
+func (k Keeper) AppendRestakeRecord(ctx sdk.Context, record restakingv1.RestakeRecord) error {
+	delegator, err := sdk.AccAddressFromBech32(record.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	seq, err := k.historySequence.Get(ctx, delegator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.restakeHistory.Set(ctx, collections.Join(delegator, seq), record); err != nil {
+		return err
+	}
+	if seq >= types.MaxRestakeHistory {
+		if err := k.restakeHistory.Remove(ctx, collections.Join(delegator, seq-types.MaxRestakeHistory)); err != nil {
+			return err
+		}
+	}
+
+	return k.historySequence.Set(ctx, delegator, seq+1)
+}
+
+// GetRestakeHistory returns the delegator's recent auto-restakes, oldest first.
+func (k Keeper) GetRestakeHistory(ctx sdk.Context, delegator sdk.AccAddress) ([]restakingv1.RestakeRecord, error) {
+	var records []restakingv1.RestakeRecord
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](delegator)
+	err := k.restakeHistory.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, uint64], record restakingv1.RestakeRecord) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	})
+	return records, err
+}
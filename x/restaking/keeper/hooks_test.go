@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+type recordingHooks struct {
+	name      string
+	calls     *[]string
+	beforeErr error
+}
+
+var _ types.RestakingHooks = recordingHooks{}
+
+func (h recordingHooks) BeforeAutoRestake(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress, amount sdk.Coins) error {
+	*h.calls = append(*h.calls, h.name+":before:"+amount.String())
+	return h.beforeErr
+}
+
+func (h recordingHooks) AfterAutoRestake(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress, amount sdk.Coins) error {
+	*h.calls = append(*h.calls, h.name+":after:"+amount.String())
+	return nil
+}
+
+func (h recordingHooks) AfterAutoRestakeFailed(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress, amount sdk.Coins, reason error) error {
+	*h.calls = append(*h.calls, h.name+":failed:"+amount.String()+":"+reason.Error())
+	return errors.New("ignored")
+}
+
+func TestRestakingHooks(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	require.NoError(t, f.keeper.SetAutoRestakeRatio(f.ctx, sdkmath.LegacyMustNewDecFromStr("0.5")))
+
+	var calls []string
+	veto := &recordingHooks{name: "b", calls: &calls}
+	f.keeper.SetHooks(types.NewMultiRestakingHooks(recordingHooks{name: "a", calls: &calls}, veto))
+	require.Panics(t, func() { f.keeper.SetHooks(types.MultiRestakingHooks{}) })
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, delegator, validator, rewards))
+	require.Equal(t, []string{"a:before:50ulbt", "b:before:50ulbt", "a:after:50ulbt", "b:after:50ulbt"}, calls)
+
+	// a hook error aborts the restake, which fails and is queued for retry
+	calls = nil
+	veto.beforeErr = errors.New("vetoed")
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, delegator, validator, rewards))
+	require.Equal(t, []string{
+		"a:before:50ulbt",
+		"b:before:50ulbt",
+		"a:failed:50ulbt:vetoed",
+	}, calls)
+	require.Equal(t, sdkmath.NewInt(50), f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()])
+
+	entry, found := f.keeper.GetRetryEntry(f.ctx, delegator, 0)
+	require.True(t, found)
+	require.Equal(t, "vetoed", entry.LastError)
+}
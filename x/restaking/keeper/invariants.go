@@ -0,0 +1,358 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// RegisterInvariants registers all restaking invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "restake-statistics", RestakeStatisticsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "restaked-delegations", RestakedDelegationsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "retry-queue", RetryQueueInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "ratio-bounds", RatioBoundsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "carry-over", CarryOverInvariant(k))
+}
+
+// AllInvariants runs all restaking invariants, stopping at the first broken
+// one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			RestakeStatisticsInvariant(k),
+			RestakedDelegationsInvariant(k),
+			RetryQueueInvariant(k),
+			RatioBoundsInvariant(k),
+			CarryOverInvariant(k),
+		} {
+			if res, broken := invariant(ctx); broken {
+				return res, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// RestakeStatisticsInvariant checks that the per-delegator and per-validator
+// restake totals both add up to the chain-wide total, and that every delegator
+// with a preference that is not disabled is counted as an active restaker
+// exactly once.
+func RestakeStatisticsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg     string
+			broken  bool
+			optedIn uint64
+		)
+
+		delegatorTotal := sdkmath.ZeroInt()
+		if err := k.delegatorStats.Walk(ctx, nil, func(delAddr sdk.AccAddress, restaked sdkmath.Int) (bool, error) {
+			if !restaked.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\tdelegator %s has non-positive restaked amount %s\n", delAddr, restaked)
+			}
+			delegatorTotal = delegatorTotal.Add(restaked)
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		validatorTotal := sdkmath.ZeroInt()
+		if err := k.validatorStats.Walk(ctx, nil, func(valAddr sdk.ValAddress, restaked sdkmath.Int) (bool, error) {
+			if !restaked.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s has non-positive restaked amount %s\n", valAddr, restaked)
+			}
+			validatorTotal = validatorTotal.Add(restaked)
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		total, err := k.GetTotalRestaked(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if !delegatorTotal.Equal(total) || !validatorTotal.Equal(total) {
+			broken = true
+			msg += fmt.Sprintf("\tsum of delegator totals %s and sum of validator totals %s differ from total %s\n",
+				delegatorTotal, validatorTotal, total)
+		}
+
+		if err := k.delegatorPrefs.Walk(ctx, nil, func(_ sdk.AccAddress, pref restakingv1.DelegatorPreference) (bool, error) {
+			if !pref.Disabled {
+				optedIn++
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+		activeRestakers, err := k.GetActiveRestakers(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if activeRestakers != optedIn {
+			broken = true
+			msg += fmt.Sprintf("\t%d active restakers counted but %d delegators are opted in\n", activeRestakers, optedIn)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "restake statistics", msg), broken
+	}
+}
+
+// RestakedDelegationsInvariant checks the restakes the module tracked against
+// x/staking: the restaked shares of every delegation are worth no more tokens
+// than the delegation holds, every record in the restake history delegated a
+// positive amount of the staking bond denom, and the records of each delegator
+// and of each validator add up to no more than its restaked total, which also
+// counts the records pruned from the history since.
+func RestakedDelegationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if err := k.restakedShares.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], restaked sdkmath.LegacyDec) (bool, error) {
+			delegation, err := k.stakingKeeper.GetDelegation(ctx, key.K1(), key.K2())
+			if errors.Is(err, stakingtypes.ErrNoDelegation) {
+				broken = true
+				msg += fmt.Sprintf("\t%s restaked %s shares to %s but holds no delegation\n", key.K1(), restaked, key.K2())
+				return false, nil
+			} else if err != nil {
+				return true, err
+			}
+			val, err := k.stakingKeeper.GetValidator(ctx, key.K2())
+			if err != nil {
+				return true, err
+			}
+			if !val.DelegatorShares.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s has a delegation from %s but no delegator shares\n", key.K2(), key.K1())
+				return false, nil
+			}
+
+			restakedTokens, heldTokens := val.TokensFromShares(restaked), val.TokensFromShares(delegation.Shares)
+			if restaked.GT(delegation.Shares) || restakedTokens.GT(heldTokens) {
+				broken = true
+				msg += fmt.Sprintf("\t%s restaked %s tokens (%s shares) to %s but delegates %s tokens (%s shares)\n",
+					key.K1(), restakedTokens.TruncateInt(), restaked, key.K2(), heldTokens.TruncateInt(), delegation.Shares)
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			panic(err)
+		}
+
+		delegatorTotals := make(map[string]sdkmath.Int)
+		validatorTotals := make(map[string]sdkmath.Int)
+		add := func(totals map[string]sdkmath.Int, addr string, amount sdkmath.Int) {
+			if total, ok := totals[addr]; ok {
+				amount = amount.Add(total)
+			}
+			totals[addr] = amount
+		}
+		if err := k.restakeHistory.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], record restakingv1.RestakeRecord) (bool, error) {
+			if len(record.Amount) != 1 || record.Amount[0].Denom != bondDenom || !record.Amount[0].IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("	restake %d of %s did not delegate the bond denom %s: %s\n", key.K2(), key.K1(), bondDenom, record.Amount)
+				return false, nil
+			}
+			add(delegatorTotals, record.DelegatorAddress, record.Amount[0].Amount)
+			add(validatorTotals, record.ValidatorAddress, record.Amount[0].Amount)
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		for _, delegator := range slices.Sorted(maps.Keys(delegatorTotals)) {
+			delAddr, err := sdk.AccAddressFromBech32(delegator)
+			if err != nil {
+				panic(err)
+			}
+			tracked, err := k.GetDelegatorRestaked(ctx, delAddr)
+			if err != nil {
+				panic(err)
+			}
+			if history := delegatorTotals[delegator]; history.GT(tracked) {
+				broken = true
+				msg += fmt.Sprintf("	delegator %s restaked %s in its history but %s is tracked\n", delegator, history, tracked)
+			}
+		}
+		for _, validator := range slices.Sorted(maps.Keys(validatorTotals)) {
+			valAddr, err := sdk.ValAddressFromBech32(validator)
+			if err != nil {
+				panic(err)
+			}
+			tracked, err := k.GetValidatorRestaked(ctx, valAddr)
+			if err != nil {
+				panic(err)
+			}
+			if history := validatorTotals[validator]; history.GT(tracked) {
+				broken = true
+				msg += fmt.Sprintf("	validator %s was restaked %s in the history but %s is tracked\n", validator, history, tracked)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "restaked delegations", msg), broken
+	}
+}
+
+// RetryQueueInvariant checks that exactly the pending retry entries are
+// indexed as due, each at the height of its next attempt, and exactly the
+// failed ones as to be pruned, each at its prune height.
+func RetryQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg     string
+			broken  bool
+			pending int
+			failed  int
+		)
+
+		if err := k.retryEntries.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], entry restakingv1.RetryEntry) (bool, error) {
+			switch entry.Status {
+			case restakingv1.RetryStatus_RETRY_STATUS_PENDING:
+				pending++
+				indexed, err := k.retryDue.Has(ctx, collections.Join3(entry.NextAttemptHeight, key.K1(), key.K2()))
+				if err != nil {
+					return true, err
+				}
+				if !indexed {
+					broken = true
+					msg += fmt.Sprintf("\tpending retry %d of %s is not due at height %d\n", key.K2(), key.K1(), entry.NextAttemptHeight)
+				}
+			case restakingv1.RetryStatus_RETRY_STATUS_FAILED:
+				failed++
+				indexed, err := k.retryPrune.Has(ctx, collections.Join3(entry.PruneHeight, key.K1(), key.K2()))
+				if err != nil {
+					return true, err
+				}
+				if !indexed {
+					broken = true
+					msg += fmt.Sprintf("\tfailed retry %d of %s is not pruned at height %d\n", key.K2(), key.K1(), entry.PruneHeight)
+				}
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		due := 0
+		if err := k.retryDue.Walk(ctx, nil, func(collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+			due++
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+		if due != pending {
+			broken = true
+			msg += fmt.Sprintf("\t%d retries are due but %d entries are pending\n", due, pending)
+		}
+
+		expiring := 0
+		if err := k.retryPrune.Walk(ctx, nil, func(collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+			expiring++
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+		if expiring != failed {
+			broken = true
+			msg += fmt.Sprintf("\t%d retries are to be pruned but %d entries failed\n", expiring, failed)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "retry queue", msg), broken
+	}
+}
+
+// RatioBoundsInvariant checks that the parameters, validator overrides and
+// delegator preferences in state are valid, so every ratio lies between 0
+// and 1.
+func RatioBoundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if err := types.ValidateParams(k.GetParams(ctx)); err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tinvalid params: %s\n", err)
+		}
+
+		if err := k.validatorOverrides.Walk(ctx, nil, func(valAddr sdk.ValAddress, ratio sdkmath.LegacyDec) (bool, error) {
+			if err := types.ValidateAutoRestakeRatio(ratio); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s override %s: %s\n", valAddr, ratio, err)
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		if err := k.delegatorPrefs.Walk(ctx, nil, func(delAddr sdk.AccAddress, pref restakingv1.DelegatorPreference) (bool, error) {
+			if err := types.ValidateDelegatorPreference(pref); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tdelegator %s preference: %s\n", delAddr, err)
+			}
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "ratio bounds", msg), broken
+	}
+}
+
+// CarryOverInvariant checks that the carry-overs are all positive and that the
+// module account holds enough of the bond denom to pay them out. The module
+// account is blocked from receiving sends, but other modules may still move
+// funds to it, so it may hold more.
+func CarryOverInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		total := sdkmath.ZeroInt()
+		if err := k.carryOvers.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], amount sdkmath.Int) (bool, error) {
+			if !amount.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\tcarry-over of %s from %s is not positive: %s\n", key.K1(), key.K2(), amount)
+			}
+			total = total.Add(amount)
+			return false, nil
+		}); err != nil {
+			panic(err)
+		}
+
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			panic(err)
+		}
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(bondDenom)
+		if balance.LT(total) {
+			broken = true
+			msg += fmt.Sprintf("\tmodule account holds %s%s but the carry-overs add up to %s%s\n", balance, bondDenom, total, bondDenom)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "carry-over", msg), broken
+	}
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestInvariants(t *testing.T) {
+	f := initFixture(t)
+
+	alice := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	bob := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	missing := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	require.NoError(t, f.keeper.SetAutoRestakeRatio(f.ctx, sdkmath.LegacyOneDec()))
+
+	ratio := sdkmath.LegacyMustNewDecFromStr("0.5")
+	require.NoError(t, f.keeper.SetValidatorOverride(f.ctx, validator, sdkmath.LegacyOneDec()))
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: bob.String(),
+		Ratio:            &ratio,
+	}))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, alice, validator, rewards))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, bob, validator, rewards))
+	// the failed restake is queued for a retry
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, alice, missing, rewards))
+
+	msg, broken := keeper.AllInvariants(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+
+	// tightening the governance bounds keeps existing overrides within 0 and 1
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxValidatorRatio = ratio
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	_, broken = keeper.RatioBoundsInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+}
+
+func TestRestakedDelegationsInvariantBroken(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	// the history holds more than the delegator's tracked total
+	genState := types.DefaultGenesis()
+	genState.RestakeHistory = []restakingv1.RestakeRecord{{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100)),
+		Height:           1,
+	}}
+	genState.DelegatorStats = []restakingv1.DelegatorRestakeStats{{
+		DelegatorAddress: delegator.String(),
+		Restaked:         sdkmath.NewInt(50),
+	}}
+	genState.ValidatorStats = []restakingv1.ValidatorRestakeStats{{
+		ValidatorAddress: validator.String(),
+		Restaked:         sdkmath.NewInt(50),
+	}}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genState))
+
+	msg, broken := keeper.RestakedDelegationsInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, delegator.String())
+	require.Contains(t, msg, validator.String())
+
+	// a restake of another denom than the bond denom
+	f = initFixture(t)
+	genState.RestakeHistory[0].Amount = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genState))
+	msg, broken = keeper.RestakedDelegationsInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "10uatom")
+}
+
+func TestRestakedSharesInvariant(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	key := delegator.String() + "|" + validator.String()
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	require.NoError(t, f.keeper.RestakeDelegate(f.ctx, delegator, validator, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100))))
+
+	restaked, err := f.keeper.GetRestakedShares(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), restaked)
+	_, broken := keeper.RestakedDelegationsInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	// x/staking holding fewer shares than were restaked breaks it
+	f.stakingKeeper.delegations[key] = sdkmath.NewInt(40)
+	msg, broken := keeper.RestakedDelegationsInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "restaked 100 tokens")
+
+	// unless the undelegation went through the staking hooks, which cap the
+	// restaked shares at what is left
+	hooks := f.keeper.StakingHooks()
+	require.NoError(t, hooks.AfterDelegationModified(f.ctx, delegator, validator))
+	restaked, err = f.keeper.GetRestakedShares(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(40), restaked)
+	_, broken = keeper.RestakedDelegationsInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	// and forget them along with the delegation
+	require.NoError(t, hooks.BeforeDelegationRemoved(f.ctx, delegator, validator))
+	delete(f.stakingKeeper.delegations, key)
+	restaked, err = f.keeper.GetRestakedShares(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.True(t, restaked.IsZero())
+	_, broken = keeper.RestakedDelegationsInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+}
+
+func TestCarryOverInvariant(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	genState := types.DefaultGenesis()
+	genState.CarryOvers = []restakingv1.CarryOver{{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Amount:           sdkmath.NewInt(7),
+	}}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genState))
+
+	msg, broken := keeper.CarryOverInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "add up to 7ulbt")
+
+	// other modules may move funds to the module account, so it may hold more
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 9), sdk.NewInt64Coin("uatom", 1))
+	_, broken = keeper.CarryOverInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+}
+
+func TestRestakeStatisticsInvariantBroken(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	genState := types.DefaultGenesis()
+	genState.DelegatorStats = []restakingv1.DelegatorRestakeStats{{
+		DelegatorAddress: delegator.String(),
+		Restaked:         sdkmath.NewInt(100),
+	}}
+	genState.ValidatorStats = []restakingv1.ValidatorRestakeStats{{
+		ValidatorAddress: validator.String(),
+		Restaked:         sdkmath.NewInt(50),
+	}}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genState))
+
+	msg, broken := keeper.RestakeStatisticsInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "differ from total 100")
+}
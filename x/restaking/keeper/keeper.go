@@ -2,31 +2,142 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
 	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
 )
 
 // Keeper manages chain-wide restake parameters and execution.
 type Keeper struct {
-	storeService  corestore.KVStoreService
-	stakingKeeper types.StakingKeeper
+	storeService   corestore.KVStoreService
+	cdc            codec.Codec
+	addressCodec   address.Codec
+	stakingKeeper  types.StakingKeeper
+	distrKeeper    types.DistributionKeeper
+	slashingKeeper types.SlashingKeeper
+	bankKeeper     types.BankKeeper
+	erc20Keeper    types.ERC20Keeper
+	hooks          types.RestakingHooks
+
+	// payouts is shared by every copy of the keeper; see payoutLog.
+	payouts *payoutLog
+
+	// Address capable of executing a MsgUpdateParams message.
+	// Typically, this should be the x/gov module account.
+	authority []byte
 
 	schema             collections.Schema
-	autoRestakeRatioIt collections.Item[sdkmath.LegacyDec]
+	params             collections.Item[restakingv1.Params]
+	validatorOverrides collections.Map[sdk.ValAddress, sdkmath.LegacyDec]
+	delegatorPrefs     collections.Map[sdk.AccAddress, restakingv1.DelegatorPreference]
+	restakeHistory     collections.Map[collections.Pair[sdk.AccAddress, uint64], restakingv1.RestakeRecord]
+	historySequence    collections.Map[sdk.AccAddress, uint64]
+	delegatorStats     collections.Map[sdk.AccAddress, sdkmath.Int]
+	validatorStats     collections.Map[sdk.ValAddress, sdkmath.Int]
+	totalRestaked      collections.Item[sdkmath.Int]
+	activeRestakers    collections.Item[uint64]
+	retryEntries       collections.Map[collections.Pair[sdk.AccAddress, uint64], restakingv1.RetryEntry]
+	retryDue           collections.KeySet[collections.Triple[int64, sdk.AccAddress, uint64]]
+	retryPrune         collections.KeySet[collections.Triple[int64, sdk.AccAddress, uint64]]
+	retrySequence      collections.Sequence
+	deferred           collections.Map[uint64, restakingv1.RewardWithdrawal]
+	deferredSequence   collections.Sequence
+	carryOvers         collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], sdkmath.Int]
+	restakedShares     collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], sdkmath.LegacyDec]
+	pendingPayouts     collections.Map[uint64, restakingv1.RewardWithdrawal]
+	compoundCursor     collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 }
 
-func NewKeeper(storeService corestore.KVStoreService, stakingKeeper types.StakingKeeper) Keeper {
+func NewKeeper(
+	storeService corestore.KVStoreService,
+	cdc codec.Codec,
+	addressCodec address.Codec,
+	authority []byte,
+	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
+	slashingKeeper types.SlashingKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	if _, err := addressCodec.BytesToString(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 
 	k := Keeper{
-		storeService:       storeService,
-		stakingKeeper:      stakingKeeper,
-		autoRestakeRatioIt: collections.NewItem(sb, types.AutoRestakeRatioKey, "auto_restake_ratio", sdk.LegacyDecValue),
+		storeService:   storeService,
+		cdc:            cdc,
+		addressCodec:   addressCodec,
+		stakingKeeper:  stakingKeeper,
+		distrKeeper:    distrKeeper,
+		slashingKeeper: slashingKeeper,
+		bankKeeper:     bankKeeper,
+		authority:      authority,
+		payouts:        newPayoutLog(),
+		params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[restakingv1.Params](cdc)),
+		validatorOverrides: collections.NewMap(
+			sb, types.ValidatorOverrideKey, "validator_overrides", sdk.ValAddressKey, sdk.LegacyDecValue,
+		),
+		delegatorPrefs: collections.NewMap(
+			sb, types.DelegatorPreferenceKey, "delegator_preferences", sdk.AccAddressKey,
+			codec.CollValue[restakingv1.DelegatorPreference](cdc),
+		),
+		restakeHistory: collections.NewMap(
+			sb, types.RestakeHistoryKey, "restake_history",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[restakingv1.RestakeRecord](cdc),
+		),
+		historySequence: collections.NewMap(
+			sb, types.HistorySequenceKey, "history_sequence", sdk.AccAddressKey, collections.Uint64Value,
+		),
+		delegatorStats:  collections.NewMap(sb, types.DelegatorStatsKey, "delegator_stats", sdk.AccAddressKey, sdk.IntValue),
+		validatorStats:  collections.NewMap(sb, types.ValidatorStatsKey, "validator_stats", sdk.ValAddressKey, sdk.IntValue),
+		totalRestaked:   collections.NewItem(sb, types.TotalRestakedKey, "total_restaked", sdk.IntValue),
+		activeRestakers: collections.NewItem(sb, types.ActiveRestakersKey, "active_restakers", collections.Uint64Value),
+		retryEntries: collections.NewMap(
+			sb, types.RetryEntryKey, "retry_entries",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[restakingv1.RetryEntry](cdc),
+		),
+		retryDue: collections.NewKeySet(
+			sb, types.RetryDueKey, "retry_due",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		retryPrune: collections.NewKeySet(
+			sb, types.RetryPruneKey, "retry_prune",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		retrySequence: collections.NewSequence(sb, types.RetrySequenceKey, "retry_sequence"),
+		deferred: collections.NewMap(
+			sb, types.DeferredWithdrawalKey, "deferred_withdrawals", collections.Uint64Key,
+			codec.CollValue[restakingv1.RewardWithdrawal](cdc),
+		),
+		deferredSequence: collections.NewSequence(sb, types.DeferredSequenceKey, "deferred_sequence"),
+		carryOvers: collections.NewMap(
+			sb, types.CarryOverKey, "carry_overs",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), sdk.IntValue,
+		),
+		restakedShares: collections.NewMap(
+			sb, types.RestakedSharesKey, "restaked_shares",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), sdk.LegacyDecValue,
+		),
+		pendingPayouts: collections.NewMap(
+			sb, types.PendingPayoutKey, "pending_payouts", collections.Uint64Key,
+			codec.CollValue[restakingv1.RewardWithdrawal](cdc),
+		),
+		compoundCursor: collections.NewItem(
+			sb, types.CompoundCursorKey, "compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -43,33 +154,89 @@ func (k Keeper) Schema() collections.Schema {
 	return k.schema
 }
 
-// GetAutoRestakeRatio returns the configured auto restake ratio or the default.
-func (k Keeper) GetAutoRestakeRatio(ctx sdk.Context) sdkmath.LegacyDec {
-	ratio, err := k.autoRestakeRatioIt.Get(ctx)
+// Hooks returns the restaking hooks, or no-op hooks when none are set.
+func (k Keeper) Hooks() types.RestakingHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiRestakingHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the restaking hooks. In contrast to other receivers, this
+// method must take a pointer due to nature of the hooks interface and SDK
+// conventions.
+func (k *Keeper) SetHooks(rh types.RestakingHooks) {
+	if k.hooks != nil {
+		panic("cannot set restaking hooks twice")
+	}
+
+	k.hooks = rh
+}
+
+// SetERC20Keeper sets the x/erc20 keeper used to convert rewards under
+// NON_BOND_DENOM_POLICY_CONVERT_ERC20. It is set once the EVM modules are
+// built, after the restaking keeper; until then such conversions fail and the
+// rewards stay liquid.
+func (k *Keeper) SetERC20Keeper(ek types.ERC20Keeper) {
+	if k.erc20Keeper != nil {
+		panic("cannot set restaking erc20 keeper twice")
+	}
+
+	k.erc20Keeper = ek
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() []byte {
+	return k.authority
+}
+
+// GetParams returns the stored module parameters or the defaults.
+func (k Keeper) GetParams(ctx sdk.Context) restakingv1.Params {
+	params, err := k.params.Get(ctx)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return types.DefaultAutoRestakeRatioDec()
+			return types.DefaultParams()
 		}
 		panic(err)
 	}
-	return ratio
+	return params
 }
 
-// SetAutoRestakeRatio stores a new auto restake ratio after validation.
-func (k Keeper) SetAutoRestakeRatio(ctx sdk.Context, ratio sdkmath.LegacyDec) error {
-	if err := types.ValidateAutoRestakeRatio(ratio); err != nil {
+// SetParams stores the module parameters after validation.
+func (k Keeper) SetParams(ctx sdk.Context, params restakingv1.Params) error {
+	if err := types.ValidateParams(params); err != nil {
 		return err
 	}
-	return k.autoRestakeRatioIt.Set(ctx, ratio)
+	return k.params.Set(ctx, params)
+}
+
+// GetAutoRestakeRatio returns the configured auto restake ratio or the default.
+func (k Keeper) GetAutoRestakeRatio(ctx sdk.Context) sdkmath.LegacyDec {
+	return k.GetParams(ctx).AutoRestakeRatio
+}
+
+// SetAutoRestakeRatio stores a new auto restake ratio after validation.
+func (k Keeper) SetAutoRestakeRatio(ctx sdk.Context, ratio sdkmath.LegacyDec) error {
+	params := k.GetParams(ctx)
+	params.AutoRestakeRatio = ratio
+	return k.SetParams(ctx, params)
 }
 
-// AutoRestakeRewards calculates the portion of rewards to restake based on the global ratio.
-func (k Keeper) AutoRestakeRewards(ctx sdk.Context, rewards sdk.Coins) sdk.Coins {
+// AutoRestakeRewards calculates the portion of the delegator's rewards paid by
+// validator that is restaked.
+func (k Keeper) AutoRestakeRewards(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, rewards sdk.Coins) sdk.Coins {
 	if rewards.IsZero() {
 		return nil
 	}
 
-	ratio := k.GetAutoRestakeRatio(ctx)
+	return restakePortion(rewards, k.ResolveAutoRestakeRatio(ctx, delegator, validator))
+}
+
+// restakePortion returns ratio of rewards, truncating each coin, or nil when
+// nothing is left.
+func restakePortion(rewards sdk.Coins, ratio sdkmath.LegacyDec) sdk.Coins {
 	if ratio.IsZero() {
 		return nil
 	}
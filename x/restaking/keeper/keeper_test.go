@@ -0,0 +1,362 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+type fixture struct {
+	ctx           sdk.Context
+	cms           storetypes.CommitMultiStore
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	stakingKeeper *mockStakingKeeper
+	distrKeeper   *mockDistributionKeeper
+	slashKeeper   *mockSlashingKeeper
+	bankKeeper    *mockBankKeeper
+	erc20Keeper   *mockERC20Keeper
+}
+
+func initFixture(t testing.TB) *fixture {
+	t.Helper()
+
+	f := initFixtureWithoutERC20(t)
+	f.keeper.SetERC20Keeper(f.erc20Keeper)
+	return f
+}
+
+// initFixtureWithoutERC20 returns a fixture whose keeper has no x/erc20
+// keeper set yet, like the one depinject builds for the app.
+func initFixtureWithoutERC20(t testing.TB) *fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	storeService := runtime.NewKVStoreService(storeKey)
+	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	stakingKeeper := newMockStakingKeeper("ulbt")
+	slashKeeper := newMockSlashingKeeper()
+	bankKeeper := newMockBankKeeper()
+	distrKeeper := newMockDistributionKeeper(bankKeeper)
+	erc20Keeper := newMockERC20Keeper(bankKeeper)
+
+	k := keeper.NewKeeper(
+		storeService,
+		encCfg.Codec,
+		addressCodec,
+		authority,
+		stakingKeeper,
+		distrKeeper,
+		slashKeeper,
+		bankKeeper,
+	)
+
+	return &fixture{
+		ctx:           testCtx.Ctx,
+		cms:           testCtx.CMS,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+		slashKeeper:   slashKeeper,
+		bankKeeper:    bankKeeper,
+		erc20Keeper:   erc20Keeper,
+	}
+}
+
+func TestAutoRestakeRewards(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000), sdk.NewInt64Coin("uatom", 3))
+
+	// default ratio of 25% truncates dust coins away
+	portion := f.keeper.AutoRestakeRewards(f.ctx, delegator, validator, rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 250)), portion)
+
+	require.NoError(t, f.keeper.SetAutoRestakeRatio(f.ctx, sdkmath.LegacyOneDec()))
+	require.Equal(t, rewards, f.keeper.AutoRestakeRewards(f.ctx, delegator, validator, rewards))
+
+	require.NoError(t, f.keeper.SetAutoRestakeRatio(f.ctx, sdkmath.LegacyZeroDec()))
+	require.Nil(t, f.keeper.AutoRestakeRewards(f.ctx, delegator, validator, rewards))
+
+	require.Error(t, f.keeper.SetAutoRestakeRatio(f.ctx, sdkmath.LegacyMustNewDecFromStr("1.5")))
+}
+
+// -----------------------------------------------------------------------------
+// Mocks
+// -----------------------------------------------------------------------------
+
+type mockStakingKeeper struct {
+	validators   map[string]stakingtypes.Validator
+	delegations  map[string]sdkmath.Int
+	bondDenomStr string
+}
+
+func newMockStakingKeeper(bondDenom string) *mockStakingKeeper {
+	return &mockStakingKeeper{
+		validators:   make(map[string]stakingtypes.Validator),
+		delegations:  make(map[string]sdkmath.Int),
+		bondDenomStr: bondDenom,
+	}
+}
+
+// addValidator stores val, defaulting to a bonded validator without
+// commission or delegations.
+func (m *mockStakingKeeper) addValidator(val stakingtypes.Validator) {
+	if val.Status == stakingtypes.Unspecified {
+		val.Status = stakingtypes.Bonded
+	}
+	if val.Commission.Rate.IsNil() {
+		val.Commission.Rate = sdkmath.LegacyZeroDec()
+	}
+	if val.DelegatorShares.IsNil() {
+		val.DelegatorShares = sdkmath.LegacyZeroDec()
+	}
+	if val.Tokens.IsNil() {
+		val.Tokens = sdkmath.ZeroInt()
+	}
+	m.validators[val.OperatorAddress] = val
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	val, ok := m.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+func (m *mockStakingKeeper) Validator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+	return m.GetValidator(ctx, addr)
+}
+
+func (m *mockStakingKeeper) Delegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationI, error) {
+	amt, ok := m.delegations[delAddr.String()+"|"+valAddr.String()]
+	if !ok {
+		return nil, stakingtypes.ErrNoDelegation
+	}
+	return stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), sdkmath.LegacyNewDecFromInt(amt)), nil
+}
+
+func (m *mockStakingKeeper) GetDelegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error) {
+	amt, ok := m.delegations[delAddr.String()+"|"+valAddr.String()]
+	if !ok {
+		return stakingtypes.Delegation{}, stakingtypes.ErrNoDelegation
+	}
+	return stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), sdkmath.LegacyNewDecFromInt(amt)), nil
+}
+
+func (m *mockStakingKeeper) GetDelegatorDelegations(_ context.Context, delAddr sdk.AccAddress, _ uint16) ([]stakingtypes.Delegation, error) {
+	var delegations []stakingtypes.Delegation
+	for key, amt := range m.delegations {
+		if valAddr, ok := strings.CutPrefix(key, delAddr.String()+"|"); ok {
+			delegations = append(delegations, stakingtypes.NewDelegation(delAddr.String(), valAddr, sdkmath.LegacyNewDecFromInt(amt)))
+		}
+	}
+	return delegations, nil
+}
+
+func (m *mockStakingKeeper) Delegate(_ context.Context, delAddr sdk.AccAddress, amt sdkmath.Int, _ stakingtypes.BondStatus, validator stakingtypes.Validator, _ bool) (sdkmath.LegacyDec, error) {
+	key := delAddr.String() + "|" + validator.OperatorAddress
+	current, ok := m.delegations[key]
+	if !ok {
+		current = sdkmath.ZeroInt()
+	}
+	m.delegations[key] = current.Add(amt)
+	if val, ok := m.validators[validator.OperatorAddress]; ok {
+		val.DelegatorShares = val.DelegatorShares.Add(sdkmath.LegacyNewDecFromInt(amt))
+		val.Tokens = val.Tokens.Add(amt)
+		m.validators[validator.OperatorAddress] = val
+	}
+	return sdkmath.LegacyNewDecFromInt(amt), nil
+}
+
+func (m *mockStakingKeeper) BondDenom(_ context.Context) (string, error) {
+	return m.bondDenomStr, nil
+}
+
+func (m *mockStakingKeeper) GetAllValidators(context.Context) ([]stakingtypes.Validator, error) {
+	validators := make([]stakingtypes.Validator, 0, len(m.validators))
+	for _, val := range m.validators {
+		validators = append(validators, val)
+	}
+	return validators, nil
+}
+
+type mockDistributionKeeper struct {
+	bank          *mockBankKeeper
+	rewards       map[string]sdk.DecCoins
+	withdrawAddrs map[string]sdk.AccAddress
+	communityPool sdk.Coins
+}
+
+func newMockDistributionKeeper(bank *mockBankKeeper) *mockDistributionKeeper {
+	return &mockDistributionKeeper{
+		bank:          bank,
+		rewards:       make(map[string]sdk.DecCoins),
+		withdrawAddrs: make(map[string]sdk.AccAddress),
+	}
+}
+
+func (m *mockDistributionKeeper) setRewards(delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.DecCoins) {
+	m.rewards[delAddr.String()+"|"+valAddr.String()] = rewards
+}
+
+func (m *mockDistributionKeeper) WithdrawDelegationRewards(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	key := delAddr.String() + "|" + valAddr.String()
+	rewards, _ := m.rewards[key].TruncateDecimal()
+	delete(m.rewards, key)
+	return rewards, nil
+}
+
+func (m *mockDistributionKeeper) IncrementValidatorPeriod(context.Context, stakingtypes.ValidatorI) (uint64, error) {
+	return 1, nil
+}
+
+func (m *mockDistributionKeeper) CalculateDelegationRewards(_ context.Context, _ stakingtypes.ValidatorI, del stakingtypes.DelegationI, _ uint64) (sdk.DecCoins, error) {
+	return m.rewards[del.GetDelegatorAddr()+"|"+del.GetValidatorAddr()], nil
+}
+
+func (m *mockDistributionKeeper) GetValidatorOutstandingRewardsCoins(_ context.Context, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	outstanding := sdk.NewDecCoins()
+	for key, rewards := range m.rewards {
+		if strings.HasSuffix(key, "|"+valAddr.String()) {
+			outstanding = outstanding.Add(rewards...)
+		}
+	}
+	return outstanding, nil
+}
+
+func (m *mockDistributionKeeper) GetDelegatorWithdrawAddr(_ context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error) {
+	if addr, ok := m.withdrawAddrs[delAddr.String()]; ok {
+		return addr, nil
+	}
+	return delAddr, nil
+}
+
+func (m *mockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := m.bank.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount); err != nil {
+		return err
+	}
+	m.communityPool = m.communityPool.Add(amount...)
+	return nil
+}
+
+type mockSlashingKeeper struct {
+	signingInfos map[string]slashingtypes.ValidatorSigningInfo
+	window       int64
+}
+
+func newMockSlashingKeeper() *mockSlashingKeeper {
+	return &mockSlashingKeeper{
+		signingInfos: make(map[string]slashingtypes.ValidatorSigningInfo),
+		window:       100,
+	}
+}
+
+func (m *mockSlashingKeeper) GetValidatorSigningInfo(_ context.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error) {
+	info, ok := m.signingInfos[consAddr.String()]
+	if !ok {
+		return slashingtypes.ValidatorSigningInfo{}, slashingtypes.ErrNoSigningInfoFound
+	}
+	return info, nil
+}
+
+func (m *mockSlashingKeeper) SignedBlocksWindow(context.Context) (int64, error) {
+	return m.window, nil
+}
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance := m.balances[from.String()]
+	if !balance.IsAllGTE(amt) {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[from.String()] = balance.Sub(amt...)
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+// mockERC20Keeper converts coins of registered denoms by moving them to the
+// erc20 module account and crediting the receiver's token balance.
+type mockERC20Keeper struct {
+	bank     *mockBankKeeper
+	pairs    map[string]bool
+	balances map[string]sdk.Coins
+}
+
+func newMockERC20Keeper(bank *mockBankKeeper) *mockERC20Keeper {
+	return &mockERC20Keeper{
+		bank:     bank,
+		pairs:    make(map[string]bool),
+		balances: make(map[string]sdk.Coins),
+	}
+}
+
+func (m *mockERC20Keeper) ConvertCoin(ctx context.Context, msg *erc20types.MsgConvertCoin) (*erc20types.MsgConvertCoinResponse, error) {
+	if !m.pairs[msg.Coin.Denom] {
+		return nil, erc20types.ErrTokenPairNotFound
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.bank.SendCoinsFromAccountToModule(ctx, sender, erc20types.ModuleName, sdk.NewCoins(msg.Coin)); err != nil {
+		return nil, err
+	}
+	receiver := common.HexToAddress(msg.Receiver).Hex()
+	m.balances[receiver] = m.balances[receiver].Add(msg.Coin)
+	return &erc20types.MsgConvertCoinResponse{}, nil
+}
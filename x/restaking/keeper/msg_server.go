@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+var _ restakingv1.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the restaking Msg service. It
+// holds on to k, so that keepers set on it later, such as the x/erc20 one,
+// are seen by the service.
+func NewMsgServerImpl(k *Keeper) restakingv1.MsgServer {
+	return msgServer{keeper: k}
+}
+
+// UpdateParams updates the module parameters. Only the module authority may call it.
+func (m msgServer) UpdateParams(ctx context.Context, req *restakingv1.MsgUpdateParams) (*restakingv1.MsgUpdateParamsResponse, error) {
+	authority, err := m.keeper.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(m.keeper.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := m.keeper.addressCodec.BytesToString(m.keeper.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	if err := types.ValidateParams(req.Params); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := m.keeper.SetParams(sdk.UnwrapSDKContext(ctx), req.Params); err != nil {
+		return nil, err
+	}
+
+	return &restakingv1.MsgUpdateParamsResponse{}, nil
+}
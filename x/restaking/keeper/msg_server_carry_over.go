@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// ClaimCarryOver pays the signing delegator's carry-over back to it.
+func (m msgServer) ClaimCarryOver(ctx context.Context, msg *restakingv1.MsgClaimCarryOver) (*restakingv1.MsgClaimCarryOverResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	var valAddr sdk.ValAddress
+	if msg.ValidatorAddress != "" {
+		valAddr, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid validator address: %s", err)
+		}
+	}
+
+	claimed, err := m.keeper.ClaimCarryOver(sdkCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if claimed.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrCarryOverNotFound, "delegator %s", msg.DelegatorAddress)
+	}
+
+	return &restakingv1.MsgClaimCarryOverResponse{Amount: claimed}, nil
+}
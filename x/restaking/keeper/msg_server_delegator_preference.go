@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SetDelegatorPreference replaces the signing delegator's auto-restake preference.
+func (m msgServer) SetDelegatorPreference(ctx context.Context, msg *restakingv1.MsgSetDelegatorPreference) (*restakingv1.MsgSetDelegatorPreferenceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pref := restakingv1.DelegatorPreference{
+		DelegatorAddress:         msg.DelegatorAddress,
+		Disabled:                 msg.Disabled,
+		Ratio:                    msg.Ratio,
+		ValidatorPreferences:     msg.ValidatorPreferences,
+		FallbackValidatorAddress: msg.FallbackValidatorAddress,
+		DiversificationTargets:   msg.DiversificationTargets,
+	}
+	if err := m.keeper.SetDelegatorPreference(sdkCtx, pref); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPreference, err.Error())
+	}
+
+	return &restakingv1.MsgSetDelegatorPreferenceResponse{}, nil
+}
+
+// ClearDelegatorPreference removes the signing delegator's preference.
+func (m msgServer) ClearDelegatorPreference(ctx context.Context, msg *restakingv1.MsgClearDelegatorPreference) (*restakingv1.MsgClearDelegatorPreferenceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	if _, found := m.keeper.GetDelegatorPreference(sdkCtx, delAddr); !found {
+		return nil, errorsmod.Wrap(types.ErrPreferenceNotFound, msg.DelegatorAddress)
+	}
+
+	if err := m.keeper.DeleteDelegatorPreference(sdkCtx, delAddr); err != nil {
+		return nil, err
+	}
+
+	return &restakingv1.MsgClearDelegatorPreferenceResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestMsgDelegatorPreference(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	valA := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	valB := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: valA.String()})
+
+	half := sdkmath.LegacyMustNewDecFromStr("0.5")
+	one := sdkmath.LegacyOneDec()
+	tooHigh := sdkmath.LegacyMustNewDecFromStr("1.5")
+
+	testCases := []struct {
+		name      string
+		input     *restakingv1.MsgSetDelegatorPreference
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			input:     &restakingv1.MsgSetDelegatorPreference{DelegatorAddress: "invalid"},
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "ratio above one",
+			input:     &restakingv1.MsgSetDelegatorPreference{DelegatorAddress: delegator.String(), Ratio: &tooHigh},
+			expErrMsg: "between 0 and 1",
+		},
+		{
+			name: "validator entry without choice",
+			input: &restakingv1.MsgSetDelegatorPreference{
+				DelegatorAddress:     delegator.String(),
+				ValidatorPreferences: []restakingv1.ValidatorPreference{{ValidatorAddress: valA.String()}},
+			},
+			expErrMsg: "exactly one of disabled or ratio",
+		},
+		{
+			name: "duplicate validator entry",
+			input: &restakingv1.MsgSetDelegatorPreference{
+				DelegatorAddress: delegator.String(),
+				ValidatorPreferences: []restakingv1.ValidatorPreference{
+					{ValidatorAddress: valA.String(), Disabled: true},
+					{ValidatorAddress: valA.String(), Ratio: &half},
+				},
+			},
+			expErrMsg: "duplicate preference",
+		},
+		{
+			name: "duplicate diversification target",
+			input: &restakingv1.MsgSetDelegatorPreference{
+				DelegatorAddress: delegator.String(),
+				DiversificationTargets: []restakingv1.DiversificationTarget{
+					{ValidatorAddress: valA.String(), Weight: 1},
+					{ValidatorAddress: valA.String(), Weight: 2},
+				},
+			},
+			expErrMsg: "duplicate diversification target",
+		},
+		{
+			name: "zero diversification weight",
+			input: &restakingv1.MsgSetDelegatorPreference{
+				DelegatorAddress:       delegator.String(),
+				DiversificationTargets: []restakingv1.DiversificationTarget{{ValidatorAddress: valA.String()}},
+			},
+			expErrMsg: "must be positive",
+		},
+		{
+			name: "all good",
+			input: &restakingv1.MsgSetDelegatorPreference{
+				DelegatorAddress:     delegator.String(),
+				Disabled:             true,
+				ValidatorPreferences: []restakingv1.ValidatorPreference{{ValidatorAddress: valA.String(), Ratio: &one}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.SetDelegatorPreference(f.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// opted out everywhere except valA, which compounds fully
+	require.Equal(t, one, f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, valA))
+	require.True(t, f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, valB).IsZero())
+
+	// the delegator preference wins over a validator override
+	require.NoError(t, f.keeper.SetValidatorOverride(f.ctx, valB, half))
+	require.True(t, f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, valB).IsZero())
+
+	res, err := qs.DelegatorPreference(f.ctx, &restakingv1.QueryDelegatorPreferenceRequest{DelegatorAddress: delegator.String()})
+	require.NoError(t, err)
+	require.True(t, res.Preference.Disabled)
+	require.Len(t, res.Preference.ValidatorPreferences, 1)
+
+	_, err = ms.ClearDelegatorPreference(f.ctx, &restakingv1.MsgClearDelegatorPreference{DelegatorAddress: delegator.String()})
+	require.NoError(t, err)
+	_, err = ms.ClearDelegatorPreference(f.ctx, &restakingv1.MsgClearDelegatorPreference{DelegatorAddress: delegator.String()})
+	require.ErrorIs(t, err, types.ErrPreferenceNotFound)
+
+	_, err = qs.DelegatorPreference(f.ctx, &restakingv1.QueryDelegatorPreferenceRequest{DelegatorAddress: delegator.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// without a preference the validator override and global ratio apply again
+	require.Equal(t, half, f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, valB))
+	require.Equal(t, types.DefaultAutoRestakeRatioDec(), f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, valA))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// CancelRetry drops one of the signing delegator's queued retries.
+func (m msgServer) CancelRetry(ctx context.Context, msg *restakingv1.MsgCancelRetry) (*restakingv1.MsgCancelRetryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	entry, found := m.keeper.GetRetryEntry(sdkCtx, delAddr, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRetryNotFound, "retry %d of %s", msg.Id, msg.DelegatorAddress)
+	}
+
+	if err := m.keeper.CancelRetry(sdkCtx, delAddr, entry); err != nil {
+		return nil, err
+	}
+
+	return &restakingv1.MsgCancelRetryResponse{}, nil
+}
+
+// ForceRetry attempts one of the signing delegator's queued retries now.
+func (m msgServer) ForceRetry(ctx context.Context, msg *restakingv1.MsgForceRetry) (*restakingv1.MsgForceRetryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	entry, found := m.keeper.GetRetryEntry(sdkCtx, delAddr, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRetryNotFound, "retry %d of %s", msg.Id, msg.DelegatorAddress)
+	}
+
+	if err := m.keeper.ForceRetry(sdkCtx, delAddr, entry); err != nil {
+		return nil, errorsmod.Wrap(types.ErrRetryFailed, err.Error())
+	}
+
+	return &restakingv1.MsgForceRetryResponse{}, nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SetValidatorOverride sets the auto-restake ratio for rewards paid by the signing validator.
+func (m msgServer) SetValidatorOverride(ctx context.Context, msg *restakingv1.MsgSetValidatorOverride) (*restakingv1.MsgSetValidatorOverrideResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	valAddr, err := m.validatorFromMsg(ctx, msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SetValidatorOverride(sdkCtx, valAddr, msg.Ratio); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRatio, err.Error())
+	}
+
+	return &restakingv1.MsgSetValidatorOverrideResponse{}, nil
+}
+
+// ClearValidatorOverride removes the signing validator's override.
+func (m msgServer) ClearValidatorOverride(ctx context.Context, msg *restakingv1.MsgClearValidatorOverride) (*restakingv1.MsgClearValidatorOverrideResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+
+	if _, found := m.keeper.GetValidatorOverride(sdkCtx, valAddr); !found {
+		return nil, errorsmod.Wrap(types.ErrOverrideNotFound, msg.ValidatorAddress)
+	}
+
+	if err := m.keeper.DeleteValidatorOverride(sdkCtx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &restakingv1.MsgClearValidatorOverrideResponse{}, nil
+}
+
+// validatorFromMsg parses an operator address and ensures the validator exists.
+func (m msgServer) validatorFromMsg(ctx context.Context, operator string) (sdk.ValAddress, error) {
+	valAddr, err := sdk.ValAddressFromBech32(operator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+
+	if _, err := m.keeper.stakingKeeper.GetValidator(ctx, valAddr); err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, errorsmod.Wrap(types.ErrValidatorNotFound, operator)
+		}
+		return nil, errorsmod.Wrap(err, "failed to fetch validator")
+	}
+
+	return valAddr, nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestMsgValidatorOverride(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	unknown := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	params := types.DefaultParams()
+	params.MinValidatorRatio = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.MaxValidatorRatio = sdkmath.LegacyMustNewDecFromStr("0.8")
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	testCases := []struct {
+		name      string
+		input     *restakingv1.MsgSetValidatorOverride
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			input:     &restakingv1.MsgSetValidatorOverride{ValidatorAddress: "invalid", Ratio: sdkmath.LegacyMustNewDecFromStr("0.5")},
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:      "unknown validator",
+			input:     &restakingv1.MsgSetValidatorOverride{ValidatorAddress: unknown.String(), Ratio: sdkmath.LegacyMustNewDecFromStr("0.5")},
+			expErrMsg: "validator not found",
+		},
+		{
+			name:      "below min",
+			input:     &restakingv1.MsgSetValidatorOverride{ValidatorAddress: validator.String(), Ratio: sdkmath.LegacyMustNewDecFromStr("0.05")},
+			expErrMsg: "must be between",
+		},
+		{
+			name:      "above max",
+			input:     &restakingv1.MsgSetValidatorOverride{ValidatorAddress: validator.String(), Ratio: sdkmath.LegacyMustNewDecFromStr("0.9")},
+			expErrMsg: "must be between",
+		},
+		{
+			name:  "all good",
+			input: &restakingv1.MsgSetValidatorOverride{ValidatorAddress: validator.String(), Ratio: sdkmath.LegacyMustNewDecFromStr("0.5")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.SetValidatorOverride(f.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500)), f.keeper.AutoRestakeRewards(f.ctx, delegator, validator, rewards))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 250)), f.keeper.AutoRestakeRewards(f.ctx, delegator, unknown, rewards))
+
+	res, err := qs.ValidatorOverride(f.ctx, &restakingv1.QueryValidatorOverrideRequest{ValidatorAddress: validator.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5"), res.Override.Ratio)
+
+	list, err := qs.ValidatorOverrides(f.ctx, &restakingv1.QueryValidatorOverridesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Overrides, 1)
+	require.Equal(t, validator.String(), list.Overrides[0].ValidatorAddress)
+
+	// tightening the bounds clamps the existing override
+	params.MaxValidatorRatio = sdkmath.LegacyMustNewDecFromStr("0.3")
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.3"), f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, validator))
+
+	_, err = ms.ClearValidatorOverride(f.ctx, &restakingv1.MsgClearValidatorOverride{ValidatorAddress: validator.String()})
+	require.NoError(t, err)
+	_, err = ms.ClearValidatorOverride(f.ctx, &restakingv1.MsgClearValidatorOverride{ValidatorAddress: validator.String()})
+	require.ErrorIs(t, err, types.ErrOverrideNotFound)
+
+	_, err = qs.ValidatorOverride(f.ctx, &restakingv1.QueryValidatorOverrideRequest{ValidatorAddress: validator.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, params.AutoRestakeRatio, f.keeper.ResolveAutoRestakeRatio(f.ctx, delegator, validator))
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	withRatio := func(ratio string) restakingv1.Params {
+		params := types.DefaultParams()
+		params.AutoRestakeRatio = sdkmath.LegacyMustNewDecFromStr(ratio)
+		return params
+	}
+
+	invertedBounds := types.DefaultParams()
+	invertedBounds.MinValidatorRatio = sdkmath.LegacyMustNewDecFromStr("0.6")
+	invertedBounds.MaxValidatorRatio = sdkmath.LegacyMustNewDecFromStr("0.4")
+
+	unknownEpoch := types.DefaultParams()
+	unknownEpoch.EpochIdentifier = "fortnight"
+
+	noBackoff := types.DefaultParams()
+	noBackoff.RetryBackoffBlocks = 0
+
+	noRetention := types.DefaultParams()
+	noRetention.FailedRetryRetentionBlocks = 0
+
+	noPolicy := types.DefaultParams()
+	noPolicy.UnhealthyValidatorPolicy = restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED
+
+	noDenomPolicy := types.DefaultParams()
+	noDenomPolicy.NonBondDenomPolicy = restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_UNSPECIFIED
+
+	duplicateDenomPolicy := types.DefaultParams()
+	duplicateDenomPolicy.DenomPolicies = []restakingv1.DenomPolicy{
+		{Denom: "uatom", Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL},
+		{Denom: "uatom", Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID},
+	}
+
+	testCases := []struct {
+		name      string
+		input     *restakingv1.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    types.DefaultParams(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "ratio above one",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withRatio("1.01"),
+			},
+			expErr:    true,
+			expErrMsg: "between 0 and 1",
+		},
+		{
+			name: "nil ratio",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    restakingv1.Params{},
+			},
+			expErr:    true,
+			expErrMsg: "cannot be nil",
+		},
+		{
+			name: "min validator ratio above max",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    invertedBounds,
+			},
+			expErr:    true,
+			expErrMsg: "exceeds max validator ratio",
+		},
+		{
+			name: "unknown epoch identifier",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    unknownEpoch,
+			},
+			expErr:    true,
+			expErrMsg: "epoch identifier",
+		},
+		{
+			name: "retries without backoff",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    noBackoff,
+			},
+			expErr:    true,
+			expErrMsg: "retry backoff blocks",
+		},
+		{
+			name: "failed retries kept forever",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    noRetention,
+			},
+			expErr:    true,
+			expErrMsg: "failed retry retention blocks",
+		},
+		{
+			name: "unspecified unhealthy validator policy",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    noPolicy,
+			},
+			expErr:    true,
+			expErrMsg: "unhealthy validator policy",
+		},
+		{
+			name: "unspecified non-bond denom policy",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    noDenomPolicy,
+			},
+			expErr:    true,
+			expErrMsg: "non-bond denom policy",
+		},
+		{
+			name: "duplicate denom policy",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    duplicateDenomPolicy,
+			},
+			expErr:    true,
+			expErrMsg: "duplicate denom policy",
+		},
+		{
+			name: "all good",
+			input: &restakingv1.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withRatio("0.35"),
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateParams(f.ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.input.Params, f.keeper.GetParams(f.ctx))
+			}
+		})
+	}
+
+	res, err := keeper.NewQueryServer(&f.keeper).Params(f.ctx, &restakingv1.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0.350000000000000000", res.AutoRestakeRatio)
+}
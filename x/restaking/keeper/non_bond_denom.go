@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// NonBondDenomPolicy returns the policy applied to the portion of rewards
+// resolved in denom, a denom other than the bond denom.
+func (k Keeper) NonBondDenomPolicy(ctx sdk.Context, denom string) restakingv1.NonBondDenomPolicy {
+	params := k.GetParams(ctx)
+	for _, dp := range params.DenomPolicies {
+		if dp.Denom == denom {
+			return dp.Policy
+		}
+	}
+	return params.NonBondDenomPolicy
+}
+
+// resolveNonBondDenoms returns the non-bond denom policy that applies to the
+// portion of the rewards paid by validator in every denom other than the bond
+// denom, without applying any. A policy that cannot apply at all is resolved
+// to leaving the portion with the delegator.
+func (k Keeper) resolveNonBondDenoms(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, rewards sdk.Coins) ([]restakingv1.NonBondDenomOutcome, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var outcomes []restakingv1.NonBondDenomOutcome
+	for _, coin := range restakePortion(rewards, k.ResolveAutoRestakeRatio(ctx, delegator, validator)) {
+		if coin.Denom == bondDenom {
+			continue
+		}
+
+		outcome := restakingv1.NonBondDenomOutcome{
+			Amount: coin,
+			Policy: k.NonBondDenomPolicy(ctx, coin.Denom),
+		}
+		if outcome.Policy == restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20 && k.erc20Keeper == nil {
+			outcome.Policy = restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID
+			outcome.Error = "erc20 conversion is not available"
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes, nil
+}
+
+// settleNonBondDenoms applies the non-bond denom policies to the portion of
+// the rewards paid by validator in every denom other than the bond denom and
+// reports what became of each. A policy that fails leaves its portion with
+// the delegator.
+func (k Keeper) settleNonBondDenoms(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, rewards sdk.Coins) ([]restakingv1.NonBondDenomOutcome, error) {
+	outcomes, err := k.resolveNonBondDenoms(ctx, delegator, validator, rewards)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, outcome := range outcomes {
+		if err := k.applyNonBondDenomPolicy(ctx, delegator, outcome.Amount, outcome.Policy); err != nil {
+			ctx.Logger().Error("auto-restake non-bond denom policy failed", "err", err, "delegator", delegator.String(), "denom", outcome.Amount.Denom)
+			outcomes[i].Policy = restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID
+			outcomes[i].Error = err.Error()
+		}
+	}
+	return outcomes, nil
+}
+
+// applyNonBondDenomPolicy applies policy, as resolved by resolveNonBondDenoms,
+// to amount, held by the delegator, leaving no state behind when it fails.
+func (k Keeper) applyNonBondDenomPolicy(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, policy restakingv1.NonBondDenomPolicy) error {
+	cacheCtx, write := ctx.CacheContext()
+	switch policy {
+	case restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL:
+		if err := k.distrKeeper.FundCommunityPool(cacheCtx, sdk.NewCoins(amount), delegator); err != nil {
+			return err
+		}
+	case restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20:
+		if _, err := k.erc20Keeper.ConvertCoin(cacheCtx, &erc20types.MsgConvertCoin{
+			Coin:     amount,
+			Receiver: common.BytesToAddress(delegator).Hex(),
+			Sender:   delegator.String(),
+		}); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	write()
+
+	return nil
+}
+
+// nonBondDenomsSettled emits the outcome of the non-bond denom policies of a
+// withdrawal none of whose restakes announced it.
+func (k Keeper) nonBondDenomsSettled(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, outcomes []restakingv1.NonBondDenomOutcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	ratio, source := k.resolveAutoRestakeRatio(ctx, delegator, validator)
+	return ctx.EventManager().EmitTypedEvent(&restakingv1.EventAutoRestake{
+		Delegator:     delegator.String(),
+		Validator:     validator.String(),
+		Ratio:         ratio,
+		RatioSource:   source,
+		Height:        ctx.BlockHeight(),
+		NonBondDenoms: outcomes,
+	})
+}
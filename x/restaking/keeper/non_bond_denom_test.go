@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestNonBondDenomPolicies(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.erc20Keeper.pairs["uatom"] = true
+
+	params := f.keeper.GetParams(f.ctx)
+	params.AutoRestakeRatio = sdkmath.LegacyMustNewDecFromStr("0.5")
+	params.NonBondDenomPolicy = restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL
+	params.DenomPolicies = []restakingv1.DenomPolicy{
+		{Denom: "uatom", Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20},
+		{Denom: ibcDenom, Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20},
+	}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.Equal(t, restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20, f.keeper.NonBondDenomPolicy(f.ctx, "uatom"))
+	require.Equal(t, restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL, f.keeper.NonBondDenomPolicy(f.ctx, "uosmo"))
+
+	rewards := sdk.NewCoins(
+		sdk.NewInt64Coin("ulbt", 1_000),
+		sdk.NewInt64Coin("uatom", 400),
+		sdk.NewInt64Coin("uosmo", 100),
+		sdk.NewInt64Coin(ibcDenom, 200),
+	)
+	f.bankKeeper.balances[delegator.String()] = rewards
+	ctx := f.ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+
+	// the bond denom is restaked and every other denom follows its policy; the
+	// ibc denom has no token pair, so it stays with the delegator
+	require.Equal(t, sdkmath.NewInt(500), f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 50)), f.distrKeeper.communityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 200)), f.erc20Keeper.balances[common.BytesToAddress(delegator).Hex()])
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("ulbt", 1_000),
+		sdk.NewInt64Coin("uatom", 200),
+		sdk.NewInt64Coin("uosmo", 50),
+		sdk.NewInt64Coin(ibcDenom, 200),
+	), f.bankKeeper.balances[delegator.String()])
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	event, ok := msg.(*restakingv1.EventAutoRestake)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500)), event.Amount)
+	require.Len(t, event.NonBondDenoms, 3)
+	require.Equal(t, sdk.NewInt64Coin(ibcDenom, 100), event.NonBondDenoms[0].Amount)
+	require.Equal(t, restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID, event.NonBondDenoms[0].Policy)
+	require.NotEmpty(t, event.NonBondDenoms[0].Error)
+	require.Equal(t, restakingv1.NonBondDenomOutcome{
+		Amount: sdk.NewInt64Coin("uatom", 200),
+		Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20,
+	}, event.NonBondDenoms[1])
+	require.Equal(t, restakingv1.NonBondDenomOutcome{
+		Amount: sdk.NewInt64Coin("uosmo", 50),
+		Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL,
+	}, event.NonBondDenoms[2])
+
+	// rewards the delegator has already spent are left where they are
+	f.bankKeeper.balances[delegator.String()] = sdk.NewCoins()
+	ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 50)), f.distrKeeper.communityPool)
+
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err = sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	event, ok = msg.(*restakingv1.EventAutoRestake)
+	require.True(t, ok)
+	require.Empty(t, event.Amount)
+	require.Equal(t, restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID, event.NonBondDenoms[0].Policy)
+	require.NotEmpty(t, event.NonBondDenoms[0].Error)
+}
+
+func TestSimulateNonBondDenomPolicies(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()] = sdkmath.NewInt(1_000)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.NonBondDenomPolicy = restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000), sdk.NewInt64Coin("uatom", 400))
+	f.distrKeeper.setRewards(delegator, validator, sdk.NewDecCoinsFromCoins(rewards...))
+	f.bankKeeper.balances[delegator.String()] = rewards
+	res, err := qs.SimulateAutoRestake(f.ctx, &restakingv1.QuerySimulateAutoRestakeRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []restakingv1.NonBondDenomOutcome{{
+		Amount: sdk.NewInt64Coin("uatom", 100),
+		Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_COMMUNITY_POOL,
+	}}, res.NonBondDenoms)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 750), sdk.NewInt64Coin("uatom", 300)), res.Liquid)
+
+	// the policy is only reported, not executed
+	require.Empty(t, f.distrKeeper.communityPool)
+}
+
+func TestSimulateConvertERC20(t *testing.T) {
+	// the app registers the query server before it sets the x/erc20 keeper
+	f := initFixtureWithoutERC20(t)
+	qs := keeper.NewQueryServer(&f.keeper)
+	f.keeper.SetERC20Keeper(f.erc20Keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()] = sdkmath.NewInt(1_000)
+	f.erc20Keeper.pairs["uatom"] = true
+
+	params := f.keeper.GetParams(f.ctx)
+	params.NonBondDenomPolicy = restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000), sdk.NewInt64Coin("uatom", 400))
+	f.distrKeeper.setRewards(delegator, validator, sdk.NewDecCoinsFromCoins(rewards...))
+	f.bankKeeper.balances[delegator.String()] = rewards
+	res, err := qs.SimulateAutoRestake(f.ctx, &restakingv1.QuerySimulateAutoRestakeRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []restakingv1.NonBondDenomOutcome{{
+		Amount: sdk.NewInt64Coin("uatom", 100),
+		Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_CONVERT_ERC20,
+	}}, res.NonBondDenoms)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 750), sdk.NewInt64Coin("uatom", 300)), res.Liquid)
+
+	// the conversion is only reported, not executed
+	require.Empty(t, f.erc20Keeper.balances)
+}
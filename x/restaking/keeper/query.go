@@ -9,18 +9,22 @@ import (
 )
 
 type queryServer struct {
-	keeper Keeper
+	keeper *Keeper
 }
 
 var _ restakingv1.QueryServer = queryServer{}
 
-// NewQueryServer returns an implementation of the restaking gRPC query service.
-func NewQueryServer(k Keeper) restakingv1.QueryServer {
+// NewQueryServer returns an implementation of the restaking gRPC query
+// service. Like NewMsgServerImpl, it holds on to k.
+func NewQueryServer(k *Keeper) restakingv1.QueryServer {
 	return queryServer{keeper: k}
 }
 
 func (q queryServer) Params(ctx context.Context, _ *restakingv1.QueryParamsRequest) (*restakingv1.QueryParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	ratio := q.keeper.GetAutoRestakeRatio(sdkCtx)
-	return &restakingv1.QueryParamsResponse{AutoRestakeRatio: ratio.String()}, nil
+	params := q.keeper.GetParams(sdkCtx)
+	return &restakingv1.QueryParamsResponse{
+		AutoRestakeRatio: params.AutoRestakeRatio.String(),
+		Params:           params,
+	}, nil
 }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) CarryOvers(ctx context.Context, req *restakingv1.QueryCarryOversRequest) (*restakingv1.QueryCarryOversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	carryOvers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.keeper.carryOvers,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], amount sdkmath.Int) (restakingv1.CarryOver, error) {
+			return restakingv1.CarryOver{
+				DelegatorAddress: key.K1().String(),
+				ValidatorAddress: key.K2().String(),
+				Amount:           amount,
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.ValAddress](delAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryCarryOversResponse{CarryOvers: carryOvers, Pagination: pageRes}, nil
+}
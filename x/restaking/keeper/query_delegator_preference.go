@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) DelegatorPreference(ctx context.Context, req *restakingv1.QueryDelegatorPreferenceRequest) (*restakingv1.QueryDelegatorPreferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pref, found := q.keeper.GetDelegatorPreference(sdk.UnwrapSDKContext(ctx), delAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no preference for delegator %s", req.DelegatorAddress)
+	}
+
+	return &restakingv1.QueryDelegatorPreferenceResponse{Preference: pref}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) RestakeHistory(ctx context.Context, req *restakingv1.QueryRestakeHistoryRequest) (*restakingv1.QueryRestakeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.keeper.restakeHistory,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], record restakingv1.RestakeRecord) (restakingv1.RestakeRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](delAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryRestakeHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) RetryEntries(ctx context.Context, req *restakingv1.QueryRetryEntriesRequest) (*restakingv1.QueryRetryEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entries, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.keeper.retryEntries,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], entry restakingv1.RetryEntry) (restakingv1.RetryEntry, error) {
+			return entry, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](delAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryRetryEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
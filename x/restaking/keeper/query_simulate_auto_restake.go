@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SimulateAutoRestake withdraws the delegator's rewards and auto-restakes them
+// on a throwaway branch of the state, following the same path as a withdrawal
+// processed at end block, and reports the outcome. The restaking hooks are
+// left out of the dry run, as are the non-bond denom policies: each portion in
+// another denom is reported with the policy that would apply to it.
+func (q queryServer) SimulateAutoRestake(ctx context.Context, req *restakingv1.QuerySimulateAutoRestakeRequest) (*restakingv1.QuerySimulateAutoRestakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// nothing below may reach the query's own state
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	if _, err := q.keeper.stakingKeeper.Delegation(cacheCtx, delAddr, valAddr); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	bondDenom, err := q.keeper.stakingKeeper.BondDenom(cacheCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rewards, err := q.keeper.distrKeeper.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	carryOver, err := q.keeper.GetCarryOver(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ratio, source := q.keeper.resolveAutoRestakeRatio(cacheCtx, delAddr, valAddr)
+	res := &restakingv1.QuerySimulateAutoRestakeResponse{
+		Rewards:     rewards,
+		Restaked:    sdk.NewCoin(bondDenom, sdkmath.ZeroInt()),
+		Liquid:      rewards,
+		Ratio:       ratio,
+		RatioSource: source,
+		CarryOver:   sdk.NewCoin(bondDenom, carryOver),
+	}
+
+	// like CommitWithdrawals, leave rewards alone in epoch mode or when they
+	// are paid to a third party
+	if rewards.IsZero() || ratio.IsZero() || q.keeper.EpochMode(cacheCtx) {
+		return res, nil
+	}
+	withdrawAddr, err := q.keeper.distrKeeper.GetDelegatorWithdrawAddr(cacheCtx, delAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return res, nil
+	}
+
+	target, _, err := q.keeper.restakeTarget(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if target == nil {
+		return res, nil
+	}
+
+	records, err := q.keeper.restakeRecords(cacheCtx, delAddr, valAddr, target, rewards)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	unpaid := q.keeper.settleCarryOver(cacheCtx, delAddr, valAddr, rewards, records)
+	if unpaid != nil && !errors.Is(unpaid, types.ErrCarryOverUnpaid) {
+		return nil, status.Error(codes.Internal, unpaid.Error())
+	}
+
+	// the carry-over is either paid back to the delegator to be restaked or
+	// grows by what is held back from the rewards
+	settled, err := q.keeper.GetCarryOver(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if settled.GT(carryOver) {
+		res.Liquid = res.Liquid.Sub(sdk.NewCoin(bondDenom, settled.Sub(carryOver)))
+	} else {
+		res.Liquid = res.Liquid.Add(sdk.NewCoin(bondDenom, carryOver.Sub(settled)))
+	}
+	res.CarryOver = sdk.NewCoin(bondDenom, settled)
+
+	res.NonBondDenoms, err = q.keeper.resolveNonBondDenoms(cacheCtx, delAddr, valAddr, rewards)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, outcome := range res.NonBondDenoms {
+		if outcome.Policy != restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID {
+			res.Liquid = res.Liquid.Sub(outcome.Amount)
+		}
+	}
+
+	for _, record := range records {
+		recordVal, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		// a restake that would fail stays liquid until it is retried, as do
+		// all of them when the carry-over could not be paid back; the
+		// restaking hooks are not run, so a restake they would veto is still
+		// reported
+		if unpaid != nil {
+			continue
+		}
+		if err := q.keeper.simulateRestake(cacheCtx, delAddr, recordVal, record.Amount); err != nil {
+			continue
+		}
+
+		amount := sdk.NewCoin(bondDenom, record.Amount.AmountOf(bondDenom))
+		res.Restaked = res.Restaked.Add(amount)
+		res.Liquid = res.Liquid.Sub(amount)
+		res.Splits = append(res.Splits, restakingv1.RestakeSplit{
+			ValidatorAddress: record.ValidatorAddress,
+			Amount:           amount,
+		})
+	}
+
+	return res, nil
+}
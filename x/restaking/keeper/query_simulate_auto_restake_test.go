@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"bytes"
+	"maps"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestSimulateAutoRestake(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	fallback := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	missing := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: fallback.String()})
+	f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()] = sdkmath.NewInt(1_000)
+	f.stakingKeeper.delegations[delegator.String()+"|"+missing.String()] = sdkmath.NewInt(1_000)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000), sdk.NewInt64Coin("uatom", 3))
+	simulate := func(validator sdk.ValAddress) *restakingv1.QuerySimulateAutoRestakeResponse {
+		t.Helper()
+
+		// the mock distribution keeper pays rewards out of a map rather than
+		// the store, so they are set again for every query
+		f.distrKeeper.setRewards(delegator, validator, sdk.NewDecCoinsFromCoins(rewards...))
+		res, err := qs.SimulateAutoRestake(f.ctx, &restakingv1.QuerySimulateAutoRestakeRequest{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator.String(),
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the default ratio of 25% restakes only the bond denom
+	require.Equal(t, &restakingv1.QuerySimulateAutoRestakeResponse{
+		Rewards:  rewards,
+		Restaked: sdk.NewInt64Coin("ulbt", 250),
+		Liquid:   sdk.NewCoins(sdk.NewInt64Coin("ulbt", 750), sdk.NewInt64Coin("uatom", 3)),
+		Splits: []restakingv1.RestakeSplit{
+			{ValidatorAddress: validator.String(), Amount: sdk.NewInt64Coin("ulbt", 250)},
+		},
+		Ratio:       sdkmath.LegacyMustNewDecFromStr("0.25"),
+		RatioSource: restakingv1.RatioSource_RATIO_SOURCE_PARAMS,
+		CarryOver:   sdk.NewInt64Coin("ulbt", 0),
+	}, simulate(validator))
+
+	// nothing was restaked or recorded
+	history, err := f.keeper.GetRestakeHistory(f.ctx, delegator)
+	require.NoError(t, err)
+	require.Empty(t, history)
+	total, err := f.keeper.GetTotalRestaked(f.ctx)
+	require.NoError(t, err)
+	require.True(t, total.IsZero())
+
+	// a restake that would fail stays liquid
+	res := simulate(missing)
+	require.True(t, res.Restaked.IsZero())
+	require.Equal(t, rewards, res.Liquid)
+	require.Empty(t, res.Splits)
+	retries, err := qs.RetryEntries(f.ctx, &restakingv1.QueryRetryEntriesRequest{DelegatorAddress: delegator.String()})
+	require.NoError(t, err)
+	require.Empty(t, retries.Entries)
+
+	// rewards paid out of a jailed validator are redirected to the fallback
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String(), Jailed: true})
+	params := f.keeper.GetParams(f.ctx)
+	params.UnhealthyValidatorPolicy = restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_REDIRECT
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress:         delegator.String(),
+		FallbackValidatorAddress: fallback.String(),
+	}))
+	require.Equal(t, []restakingv1.RestakeSplit{
+		{ValidatorAddress: fallback.String(), Amount: sdk.NewInt64Coin("ulbt", 250)},
+	}, simulate(validator).Splits)
+
+	// rewards sent to a third party are never restaked
+	f.distrKeeper.withdrawAddrs[delegator.String()] = sdk.AccAddress(bytes.Repeat([]byte{0x5}, 20))
+	res = simulate(validator)
+	require.True(t, res.Restaked.IsZero())
+	require.Equal(t, rewards, res.Liquid)
+	delete(f.distrKeeper.withdrawAddrs, delegator.String())
+
+	// a restake below the minimum is carried over, and restaked along with
+	// the rewards once the minimum is reached
+	params.MinRestakeAmount = sdkmath.NewInt(300)
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	f.bankKeeper.balances[delegator.String()] = rewards
+	res = simulate(validator)
+	require.True(t, res.Restaked.IsZero())
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 250), res.CarryOver)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 750), sdk.NewInt64Coin("uatom", 3)), res.Liquid)
+	carryOver, err := f.keeper.GetCarryOver(f.ctx, delegator, validator)
+	require.NoError(t, err)
+	require.True(t, carryOver.IsZero())
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, restakingv1.GenesisState{
+		Params: params,
+		CarryOvers: []restakingv1.CarryOver{
+			{DelegatorAddress: delegator.String(), ValidatorAddress: validator.String(), Amount: sdkmath.NewInt(100)},
+		},
+	}))
+	f.bankKeeper.balances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 100))
+	res = simulate(validator)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 350), res.Restaked)
+	require.True(t, res.CarryOver.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 750), sdk.NewInt64Coin("uatom", 3)), res.Liquid)
+
+	// withdrawals are not restaked in epoch mode
+	params.EpochIdentifier = "day"
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.True(t, simulate(validator).Restaked.IsZero())
+}
+
+func TestSimulateAutoRestakeMatchesEndBlocker(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	payer := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	valA := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	valB := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	for _, val := range []sdk.ValAddress{payer, valA, valB} {
+		f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: val.String()})
+	}
+	f.stakingKeeper.delegations[delegator.String()+"|"+payer.String()] = sdkmath.NewInt(1_000)
+
+	half := sdkmath.LegacyMustNewDecFromStr("0.5")
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: delegator.String(),
+		Ratio:            &half,
+		DiversificationTargets: []restakingv1.DiversificationTarget{
+			{ValidatorAddress: valA.String(), Weight: 1},
+			{ValidatorAddress: valB.String(), Weight: 3},
+		},
+	}))
+	var calls []string
+	f.keeper.SetHooks(recordingHooks{name: "h", calls: &calls})
+
+	// the mock staking keeper delegates into a map rather than the store, so
+	// the delegations are restored after the query
+	delegations := maps.Clone(f.stakingKeeper.delegations)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	f.distrKeeper.setRewards(delegator, payer, sdk.NewDecCoinsFromCoins(rewards...))
+	res, err := qs.SimulateAutoRestake(f.ctx, &restakingv1.QuerySimulateAutoRestakeRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: payer.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 500), res.Restaked)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500)), res.Liquid)
+	// the dry run does not reach the restaking hooks
+	require.Empty(t, calls)
+	f.stakingKeeper.delegations = delegations
+
+	// the same withdrawal made by a transaction and restaked at end block
+	f.distrKeeper.setRewards(delegator, payer, sdk.NewDecCoinsFromCoins(rewards...))
+	paid, err := f.distrKeeper.WithdrawDelegationRewards(f.ctx, delegator, payer)
+	require.NoError(t, err)
+	ctx := txContext(f)
+	payOut(t, f, ctx, delegator, delegator, payer, paid)
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+	require.NoError(t, restaking.EndBlocker(f.ctx, f.keeper))
+
+	history, err := f.keeper.GetRestakeHistory(f.ctx, delegator)
+	require.NoError(t, err)
+	require.Len(t, history, len(res.Splits))
+	restaked := sdk.NewInt64Coin("ulbt", 0)
+	for i, split := range res.Splits {
+		val, err := sdk.ValAddressFromBech32(split.ValidatorAddress)
+		require.NoError(t, err)
+		require.Equal(t, split.Amount.Amount, f.stakingKeeper.delegations[delegator.String()+"|"+val.String()])
+		require.Equal(t, split.ValidatorAddress, history[i].ValidatorAddress)
+		require.Equal(t, sdk.NewCoins(split.Amount), history[i].Amount)
+		restaked = restaked.Add(split.Amount)
+	}
+	require.Equal(t, res.Restaked, restaked)
+	carryOver, err := f.keeper.GetCarryOver(f.ctx, delegator, payer)
+	require.NoError(t, err)
+	require.Equal(t, res.CarryOver.Amount, carryOver)
+	require.Len(t, calls, 2*len(res.Splits))
+}
+
+func TestSimulateAutoRestakeInvalid(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	_, err := qs.SimulateAutoRestake(f.ctx, &restakingv1.QuerySimulateAutoRestakeRequest{
+		DelegatorAddress: "invalid",
+		ValidatorAddress: validator.String(),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.SimulateAutoRestake(f.ctx, &restakingv1.QuerySimulateAutoRestakeRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) SplitPreview(ctx context.Context, req *restakingv1.QuerySplitPreviewRequest) (*restakingv1.QuerySplitPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Rewards.IsNil() || req.Rewards.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "rewards must not be negative")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bondDenom, err := q.keeper.stakingKeeper.BondDenom(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &restakingv1.QuerySplitPreviewResponse{Restaked: sdk.NewCoin(bondDenom, sdkmath.ZeroInt())}
+	if q.keeper.ResolveAutoRestakeRatio(sdkCtx, delAddr, valAddr).IsZero() {
+		return res, nil
+	}
+
+	target, _, err := q.keeper.restakeTarget(sdkCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if target == nil {
+		return res, nil
+	}
+
+	records, err := q.keeper.restakeRecords(sdkCtx, delAddr, valAddr, target, sdk.NewCoins(sdk.NewCoin(bondDenom, req.Rewards)))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, record := range records {
+		amount := sdk.NewCoin(bondDenom, record.Amount.AmountOf(bondDenom))
+		res.Restaked = res.Restaked.Add(amount)
+		res.Splits = append(res.Splits, restakingv1.RestakeSplit{
+			ValidatorAddress: record.ValidatorAddress,
+			Amount:           amount,
+		})
+	}
+
+	return res, nil
+}
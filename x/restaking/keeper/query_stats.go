@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) DelegatorStats(ctx context.Context, req *restakingv1.QueryDelegatorStatsRequest) (*restakingv1.QueryDelegatorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	restaked, err := q.keeper.GetDelegatorRestaked(sdkCtx, delAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bondDenom, err := q.keeper.stakingKeeper.BondDenom(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryDelegatorStatsResponse{Restaked: sdk.NewCoin(bondDenom, restaked)}, nil
+}
+
+func (q queryServer) ValidatorStats(ctx context.Context, req *restakingv1.QueryValidatorStatsRequest) (*restakingv1.QueryValidatorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	restaked, err := q.keeper.GetValidatorRestaked(sdkCtx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bondDenom, err := q.keeper.stakingKeeper.BondDenom(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryValidatorStatsResponse{Restaked: sdk.NewCoin(bondDenom, restaked)}, nil
+}
+
+func (q queryServer) Stats(ctx context.Context, req *restakingv1.QueryStatsRequest) (*restakingv1.QueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	total, err := q.keeper.GetTotalRestaked(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	restakers, err := q.keeper.GetActiveRestakers(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bondDenom, err := q.keeper.stakingKeeper.BondDenom(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryStatsResponse{
+		TotalRestaked:   sdk.NewCoin(bondDenom, total),
+		ActiveRestakers: restakers,
+	}, nil
+}
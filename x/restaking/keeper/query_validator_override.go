@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func (q queryServer) ValidatorOverride(ctx context.Context, req *restakingv1.QueryValidatorOverrideRequest) (*restakingv1.QueryValidatorOverrideResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ratio, found := q.keeper.GetValidatorOverride(sdk.UnwrapSDKContext(ctx), valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no override for validator %s", req.ValidatorAddress)
+	}
+
+	return &restakingv1.QueryValidatorOverrideResponse{
+		Override: restakingv1.ValidatorOverride{ValidatorAddress: req.ValidatorAddress, Ratio: ratio},
+	}, nil
+}
+
+func (q queryServer) ValidatorOverrides(ctx context.Context, req *restakingv1.QueryValidatorOverridesRequest) (*restakingv1.QueryValidatorOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	overrides, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.keeper.validatorOverrides,
+		req.Pagination,
+		func(valAddr sdk.ValAddress, ratio sdkmath.LegacyDec) (restakingv1.ValidatorOverride, error) {
+			return restakingv1.ValidatorOverride{ValidatorAddress: valAddr.String(), Ratio: ratio}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &restakingv1.QueryValidatorOverridesResponse{Overrides: overrides, Pagination: pageRes}, nil
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// ExecuteAutoRestake delegates the resolved portion of the rewards the delegator
+// received from validator back to it, or across the delegator's
+// diversification targets, subject to the unhealthy validator policy. A
+// portion below the min_restake_amount parameter is carried over instead, and
+// the portion in other denoms is handled by the non-bond denom policies. A
+// successful restake is recorded in the delegator's history and announced with
+// EventAutoRestake; a failed one, including every restake of a carry-over that
+// could not be paid back, leaves no state behind, emits EventAutoRestakeFailed
+// and is queued for retry. Only store errors are returned.
+func (k Keeper) ExecuteAutoRestake(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, rewards sdk.Coins) error {
+	if k.ResolveAutoRestakeRatio(ctx, delegator, validator).IsZero() {
+		return nil
+	}
+
+	// the rewards are already paid out, so every policy other than a
+	// redirect leaves them with the delegator
+	target, _, err := k.restakeTarget(ctx, delegator, validator)
+	if err != nil || target == nil {
+		return err
+	}
+
+	records, err := k.restakeRecords(ctx, delegator, validator, target, rewards)
+	if err != nil {
+		return err
+	}
+	// the records restake the carry-over too, so they all fail when it could
+	// not be paid back
+	unpaid := k.settleCarryOver(ctx, delegator, validator, rewards, records)
+	if unpaid != nil && !errors.Is(unpaid, types.ErrCarryOverUnpaid) {
+		return unpaid
+	}
+	nonBond, err := k.settleNonBondDenoms(ctx, delegator, validator, rewards)
+	if err != nil {
+		return err
+	}
+
+	// each record is restaked on its own, so one failing diversification
+	// target does not hold back the others
+	for _, record := range records {
+		recordVal, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		err = unpaid
+		if err == nil {
+			err = k.executeRestake(ctx, delegator, recordVal, record.Amount)
+		}
+		if err != nil {
+			if failErr := k.autoRestakeFailed(ctx, delegator, recordVal, record.Amount, err); failErr != nil {
+				return failErr
+			}
+			if err := k.ScheduleRetry(ctx, record, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.autoRestakeExecuted(ctx, record, nonBond); err != nil {
+			return err
+		}
+		nonBond = nil
+	}
+
+	return k.nonBondDenomsSettled(ctx, delegator, validator, nonBond)
+}
+
+// restakeRecords resolves the restakes of the rewards paid by validator: one
+// per healthy diversification target when the delegator registered any, or a
+// single one to target otherwise. The delegation's carry-over is restaked
+// along with the resolved portion. Nothing is returned when the two hold no
+// bond denom, as only the bond denom can be delegated, or less than the
+// min_restake_amount parameter.
+func (k Keeper) restakeRecords(ctx sdk.Context, delegator sdk.AccAddress, validator, target sdk.ValAddress, rewards sdk.Coins) ([]restakingv1.RestakeRecord, error) {
+	ratio, source := k.resolveAutoRestakeRatio(ctx, delegator, validator)
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	carryOver, err := k.GetCarryOver(ctx, delegator, validator)
+	if err != nil {
+		return nil, err
+	}
+	amount := restakePortion(rewards, ratio).AmountOf(bondDenom).Add(carryOver)
+	if !amount.IsPositive() || amount.LT(k.GetParams(ctx).MinRestakeAmount) {
+		return nil, nil
+	}
+
+	base := restakingv1.RestakeRecord{
+		DelegatorAddress: delegator.String(),
+		Ratio:            ratio,
+		RatioSource:      source,
+		Height:           ctx.BlockHeight(),
+	}
+
+	pref, _ := k.GetDelegatorPreference(ctx, delegator)
+	if len(pref.DiversificationTargets) == 0 {
+		record := base
+		record.ValidatorAddress = target.String()
+		record.Amount = sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+		if !target.Equals(validator) {
+			record.RedirectedFrom = validator.String()
+		}
+		return []restakingv1.RestakeRecord{record}, nil
+	}
+
+	splits, err := k.splitRestake(ctx, pref.DiversificationTargets, amount)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]restakingv1.RestakeRecord, 0, len(splits))
+	for _, split := range splits {
+		record := base
+		record.ValidatorAddress = split.validator.String()
+		record.Amount = sdk.NewCoins(sdk.NewCoin(bondDenom, split.amount))
+		record.DiversifiedFrom = validator.String()
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// executeRestake delegates amount to validator, leaving no state behind when
+// the delegation fails.
+func (k Keeper) executeRestake(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.RestakeDelegate(cacheCtx, delegator, validator, amount); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// simulateRestake is executeRestake without the restaking hooks, which may
+// act outside the module and have no place in a query.
+func (k Keeper) simulateRestake(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.restakeDelegate(cacheCtx, delegator, validator, amount, types.MultiRestakingHooks{}); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// autoRestakeExecuted records a successful restake and emits its event,
+// reporting nonBond, the outcome of the non-bond denom policies of the
+// withdrawal, if any.
+func (k Keeper) autoRestakeExecuted(ctx sdk.Context, record restakingv1.RestakeRecord, nonBond []restakingv1.NonBondDenomOutcome) error {
+	if err := k.AppendRestakeRecord(ctx, record); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&restakingv1.EventAutoRestake{
+		Delegator:   record.DelegatorAddress,
+		Validator:   record.ValidatorAddress,
+		Amount:      record.Amount,
+		Ratio:       record.Ratio,
+		RatioSource: record.RatioSource,
+		Height:      record.Height,
+
+		RedirectedFrom:  record.RedirectedFrom,
+		DiversifiedFrom: record.DiversifiedFrom,
+		NonBondDenoms:   nonBond,
+	})
+}
+
+// autoRestakeFailed logs and emits the failure of a restake and runs the
+// failure hooks.
+func (k Keeper) autoRestakeFailed(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins, cause error) error {
+	ctx.Logger().Error("auto-restake failed", "err", cause, "delegator", delegator.String(), "validator", validator.String())
+
+	// a misbehaving hook must not stop the failure from being reported
+	if err := k.Hooks().AfterAutoRestakeFailed(ctx, delegator, validator, amount, cause); err != nil {
+		ctx.Logger().Error("auto-restake failed hook", "err", err, "delegator", delegator.String(), "validator", validator.String())
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&restakingv1.EventAutoRestakeFailed{
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Amount:    amount,
+		Error:     cause.Error(),
+		Height:    ctx.BlockHeight(),
+	})
+}
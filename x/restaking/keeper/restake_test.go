@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestExecuteAutoRestake(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	missing := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	require.NoError(t, f.keeper.SetValidatorOverride(f.ctx, validator, sdkmath.LegacyMustNewDecFromStr("0.5")))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000), sdk.NewInt64Coin("uatom", 80))
+	ctx := f.ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+
+	// only the bond denom is restaked, the rest is left liquid by default
+	expected := restakingv1.RestakeRecord{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 500)),
+		Ratio:            sdkmath.LegacyMustNewDecFromStr("0.5"),
+		RatioSource:      restakingv1.RatioSource_RATIO_SOURCE_VALIDATOR_OVERRIDE,
+		Height:           12,
+	}
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Equal(t, []restakingv1.RestakeRecord{expected}, history)
+	require.Equal(t, sdkmath.NewInt(500), f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()])
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	require.Equal(t, &restakingv1.EventAutoRestake{
+		Delegator:   delegator.String(),
+		Validator:   validator.String(),
+		Amount:      expected.Amount,
+		Ratio:       expected.Ratio,
+		RatioSource: expected.RatioSource,
+		Height:      12,
+		NonBondDenoms: []restakingv1.NonBondDenomOutcome{{
+			Amount: sdk.NewInt64Coin("uatom", 40),
+			Policy: restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID,
+		}},
+	}, msg)
+
+	// a restake to an unknown validator fails without touching history
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, missing, rewards))
+	history, err = f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+
+	// the other denoms are reported on an event of their own
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	msg, err = sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	failed, ok := msg.(*restakingv1.EventAutoRestakeFailed)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 250)), failed.Amount)
+	require.NotEmpty(t, failed.Error)
+	msg, err = sdk.ParseTypedEvent(events.ToABCIEvents()[1])
+	require.NoError(t, err)
+	restaked, ok := msg.(*restakingv1.EventAutoRestake)
+	require.True(t, ok)
+	require.Empty(t, restaked.Amount)
+	require.Equal(t, missing.String(), restaked.Validator)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 20), restaked.NonBondDenoms[0].Amount)
+}
+
+func TestRestakeHistoryIsBounded(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	for height := int64(1); height <= types.MaxRestakeHistory+5; height++ {
+		require.NoError(t, f.keeper.AppendRestakeRecord(f.ctx, restakingv1.RestakeRecord{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator.String(),
+			Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", height)),
+			Ratio:            sdkmath.LegacyOneDec(),
+			Height:           height,
+		}))
+	}
+
+	history, err := f.keeper.GetRestakeHistory(f.ctx, delegator)
+	require.NoError(t, err)
+	require.Len(t, history, types.MaxRestakeHistory)
+	require.Equal(t, int64(6), history[0].Height)
+	require.Equal(t, int64(types.MaxRestakeHistory+5), history[len(history)-1].Height)
+
+	// pages walk the history oldest first
+	qs := keeper.NewQueryServer(&f.keeper)
+	res, err := qs.RestakeHistory(f.ctx, &restakingv1.QueryRestakeHistoryRequest{
+		DelegatorAddress: delegator.String(),
+		Pagination:       &query.PageRequest{Limit: 10, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 10)
+	require.Equal(t, int64(6), res.Records[0].Height)
+	require.Equal(t, uint64(types.MaxRestakeHistory), res.Pagination.Total)
+
+	res, err = qs.RestakeHistory(f.ctx, &restakingv1.QueryRestakeHistoryRequest{
+		DelegatorAddress: delegator.String(),
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey, Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(16), res.Records[0].Height)
+
+	// other delegators have no history
+	res, err = qs.RestakeHistory(f.ctx, &restakingv1.QueryRestakeHistoryRequest{
+		DelegatorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x9}, 20)).String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// GetRetryEntry returns the delegator's queued retry with the given id.
+func (k Keeper) GetRetryEntry(ctx sdk.Context, delegator sdk.AccAddress, id uint64) (restakingv1.RetryEntry, bool) {
+	entry, err := k.retryEntries.Get(ctx, collections.Join(delegator, id))
+	if err != nil {
+		return restakingv1.RetryEntry{}, false
+	}
+	return entry, true
+}
+
+// ScheduleRetry queues a failed auto-restake so its delegation is attempted
+// again once the initial backoff has elapsed. Nothing is queued when retries
+// are disabled or the record carries no amount.
+func (k Keeper) ScheduleRetry(ctx sdk.Context, record restakingv1.RestakeRecord, cause error) error {
+	params := k.GetParams(ctx)
+	if params.MaxRetryAttempts == 0 || record.Amount.IsZero() {
+		return nil
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(record.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	id, err := k.retrySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.setRetryEntry(ctx, delegator, restakingv1.RetryEntry{
+		Id:                id,
+		Restake:           record,
+		Status:            restakingv1.RetryStatus_RETRY_STATUS_PENDING,
+		NextAttemptHeight: ctx.BlockHeight() + retryBackoff(params, 0),
+		LastError:         cause.Error(),
+	})
+}
+
+// ProcessRetries retries the pending entries whose backoff has elapsed, as far
+// as the block budget allows; the rest stay due for the next block. Failed
+// attempts are rescheduled with twice the previous backoff until the entry
+// runs out of attempts. Only store errors are returned.
+func (k Keeper) ProcessRetries(ctx sdk.Context, budget *BlockBudget) error {
+	remaining := budget.Remaining()
+	if remaining == 0 {
+		return nil
+	}
+
+	var due []collections.Triple[int64, sdk.AccAddress, uint64]
+	rng := collections.NewPrefixUntilTripleRange[int64, sdk.AccAddress, uint64](ctx.BlockHeight())
+	if err := k.retryDue.Walk(ctx, rng, func(key collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+		due = append(due, key)
+		return len(due) >= int(remaining), nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if budget.Exhausted() {
+			break
+		}
+
+		delegator, id := key.K2(), key.K3()
+		entry, err := k.retryEntries.Get(ctx, collections.Join(delegator, id))
+		if err != nil {
+			return err
+		}
+
+		if err := budget.run(ctx, func(ctx sdk.Context) error {
+			return k.retry(ctx, delegator, entry)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneFailedRetries drops the failed entries kept for the
+// failed_retry_retention_blocks parameter since their last attempt. Entries
+// only fail within the block budget, so the number pruned per block is bounded
+// by it too.
+func (k Keeper) PruneFailedRetries(ctx sdk.Context) error {
+	var expired []collections.Triple[int64, sdk.AccAddress, uint64]
+	rng := collections.NewPrefixUntilTripleRange[int64, sdk.AccAddress, uint64](ctx.BlockHeight())
+	if err := k.retryPrune.Walk(ctx, rng, func(key collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		delegator, id := key.K2(), key.K3()
+		entry, err := k.retryEntries.Get(ctx, collections.Join(delegator, id))
+		if err != nil {
+			return err
+		}
+		if err := k.removeRetryEntry(ctx, delegator, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelRetry drops one of the delegator's queued retries.
+func (k Keeper) CancelRetry(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry) error {
+	return k.removeRetryEntry(ctx, delegator, entry)
+}
+
+// ForceRetry attempts one of the delegator's queued retries immediately,
+// whatever its status and backoff. On failure, including when the entry no
+// longer applies, the error is returned and the entry is left as it was.
+func (k Keeper) ForceRetry(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry) error {
+	restaked, err := k.executeRetry(ctx, delegator, entry)
+	if err != nil {
+		return err
+	}
+	return k.retrySucceeded(ctx, delegator, entry, restaked)
+}
+
+// retry attempts the entry once, recording the restake on success and
+// rescheduling the entry on failure. An entry that no longer applies is
+// dropped.
+func (k Keeper) retry(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry) error {
+	restaked, cause := k.executeRetry(ctx, delegator, entry)
+	if errors.Is(cause, types.ErrRetryObsolete) {
+		ctx.Logger().Info("auto-restake retry dropped", "err", cause, "delegator", delegator.String(), "id", entry.Id)
+		return k.removeRetryEntry(ctx, delegator, entry)
+	}
+	if cause != nil {
+		return k.retryFailed(ctx, delegator, entry, cause)
+	}
+	return k.retrySucceeded(ctx, delegator, entry, restaked)
+}
+
+// executeRetry delegates the entry's amount again, leaving no state behind
+// when the delegation fails; the amount delegated is returned. The entry no
+// longer applies once auto-restake is turned off for the validator that paid
+// the rewards, or once the delegator's spendable balance falls short of the
+// amount: the rewards were spent since, and whatever is left may well be
+// funds received later.
+func (k Keeper) executeRetry(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry) (sdk.Coins, error) {
+	validator, err := sdk.ValAddressFromBech32(entry.Restake.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	paidBy, err := sdk.ValAddressFromBech32(rewardsPaidBy(entry.Restake))
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.ResolveAutoRestakeRatio(ctx, delegator, paidBy).IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrRetryObsolete, "auto-restake of the rewards paid by %s is disabled", paidBy)
+	}
+	amount := entry.Restake.Amount
+	if spendable := k.bankKeeper.SpendableCoins(ctx, delegator); !spendable.IsAllGTE(amount) {
+		return nil, errorsmod.Wrapf(types.ErrRetryObsolete, "only %s of %s is spendable", spendable, amount)
+	}
+
+	if err := k.executeRestake(ctx, delegator, validator, amount); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
+
+// rewardsPaidBy returns the validator that paid the rewards of a restake,
+// which differs from the validator delegated to when the restake was
+// redirected or diversified.
+func rewardsPaidBy(record restakingv1.RestakeRecord) string {
+	switch {
+	case record.DiversifiedFrom != "":
+		return record.DiversifiedFrom
+	case record.RedirectedFrom != "":
+		return record.RedirectedFrom
+	}
+	return record.ValidatorAddress
+}
+
+// retrySucceeded removes the entry and records the restake of the amount
+// restaked at the current height.
+func (k Keeper) retrySucceeded(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry, restaked sdk.Coins) error {
+	if err := k.removeRetryEntry(ctx, delegator, entry); err != nil {
+		return err
+	}
+
+	record := entry.Restake
+	record.Amount = restaked
+	record.Height = ctx.BlockHeight()
+	return k.autoRestakeExecuted(ctx, record, nil)
+}
+
+// retryFailed reports a failed attempt and reschedules the entry, or marks it
+// failed, to be pruned after the failed_retry_retention_blocks parameter, once
+// it has used up its attempts.
+func (k Keeper) retryFailed(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry, cause error) error {
+	validator, err := sdk.ValAddressFromBech32(entry.Restake.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.autoRestakeFailed(ctx, delegator, validator, entry.Restake.Amount, cause); err != nil {
+		return err
+	}
+	if err := k.removeRetryEntry(ctx, delegator, entry); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	entry.Attempts++
+	entry.LastError = cause.Error()
+	if entry.Attempts >= params.MaxRetryAttempts {
+		entry.Status = restakingv1.RetryStatus_RETRY_STATUS_FAILED
+		entry.NextAttemptHeight = 0
+		entry.PruneHeight = ctx.BlockHeight() + int64(params.FailedRetryRetentionBlocks)
+	} else {
+		entry.NextAttemptHeight = ctx.BlockHeight() + retryBackoff(params, entry.Attempts)
+	}
+
+	return k.setRetryEntry(ctx, delegator, entry)
+}
+
+// setRetryEntry stores the entry, indexing pending entries by the height of
+// their next attempt and failed ones by the height they are pruned at.
+func (k Keeper) setRetryEntry(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry) error {
+	if err := k.retryEntries.Set(ctx, collections.Join(delegator, entry.Id), entry); err != nil {
+		return err
+	}
+	switch entry.Status {
+	case restakingv1.RetryStatus_RETRY_STATUS_PENDING:
+		return k.retryDue.Set(ctx, collections.Join3(entry.NextAttemptHeight, delegator, entry.Id))
+	case restakingv1.RetryStatus_RETRY_STATUS_FAILED:
+		return k.retryPrune.Set(ctx, collections.Join3(entry.PruneHeight, delegator, entry.Id))
+	}
+	return nil
+}
+
+// removeRetryEntry deletes the entry and its index.
+func (k Keeper) removeRetryEntry(ctx sdk.Context, delegator sdk.AccAddress, entry restakingv1.RetryEntry) error {
+	if err := k.retryEntries.Remove(ctx, collections.Join(delegator, entry.Id)); err != nil {
+		return err
+	}
+	switch entry.Status {
+	case restakingv1.RetryStatus_RETRY_STATUS_PENDING:
+		return k.retryDue.Remove(ctx, collections.Join3(entry.NextAttemptHeight, delegator, entry.Id))
+	case restakingv1.RetryStatus_RETRY_STATUS_FAILED:
+		return k.retryPrune.Remove(ctx, collections.Join3(entry.PruneHeight, delegator, entry.Id))
+	}
+	return nil
+}
+
+// retryBackoff returns the number of blocks to wait after the given number of
+// retries, doubling the configured backoff each time.
+func retryBackoff(params restakingv1.Params, attempts uint32) int64 {
+	return int64(params.RetryBackoffBlocks << attempts)
+}
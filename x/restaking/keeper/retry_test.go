@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestRetryQueue(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyOneDec()
+	params.MaxRetryAttempts = 2
+	params.RetryBackoffBlocks = 10
+	params.FailedRetryRetentionBlocks = 1_000
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// the rewards were paid out to the delegator
+	f.bankKeeper.balances[delegator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40))
+
+	retries := func(ctx sdk.Context) []restakingv1.RetryEntry {
+		res, err := qs.RetryEntries(ctx, &restakingv1.QueryRetryEntriesRequest{DelegatorAddress: delegator.String()})
+		require.NoError(t, err)
+		return res.Entries
+	}
+
+	// the validator does not exist yet, so the restake fails and is queued
+	ctx := f.ctx.WithBlockHeight(100)
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40))))
+	entries := retries(ctx)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	require.Equal(t, restakingv1.RetryStatus_RETRY_STATUS_PENDING, entry.Status)
+	require.Equal(t, int64(110), entry.NextAttemptHeight)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40)), entry.Restake.Amount)
+	require.NotEmpty(t, entry.LastError)
+
+	// nothing happens before the backoff has elapsed
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(109), f.keeper.NewBlockBudget(ctx)))
+	require.Equal(t, entries, retries(ctx))
+
+	// each failed retry doubles the backoff
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(110), f.keeper.NewBlockBudget(ctx)))
+	entry = retries(ctx)[0]
+	require.Equal(t, uint32(1), entry.Attempts)
+	require.Equal(t, int64(130), entry.NextAttemptHeight)
+
+	// the last attempt marks the entry failed
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(130), f.keeper.NewBlockBudget(ctx)))
+	entry = retries(ctx)[0]
+	require.Equal(t, restakingv1.RetryStatus_RETRY_STATUS_FAILED, entry.Status)
+	require.Equal(t, uint32(2), entry.Attempts)
+	require.Equal(t, int64(1_130), entry.PruneHeight)
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(1_000), f.keeper.NewBlockBudget(ctx)))
+	require.Equal(t, entry, retries(ctx)[0])
+
+	// a forced retry that fails leaves the entry untouched
+	_, err := ms.ForceRetry(ctx, &restakingv1.MsgForceRetry{DelegatorAddress: delegator.String(), Id: entry.Id})
+	require.ErrorIs(t, err, types.ErrRetryFailed)
+	require.Equal(t, entry, retries(ctx)[0])
+
+	// once the validator exists the forced retry delegates and is recorded
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	ctx = ctx.WithBlockHeight(200)
+	_, err = ms.ForceRetry(ctx, &restakingv1.MsgForceRetry{DelegatorAddress: delegator.String(), Id: entry.Id})
+	require.NoError(t, err)
+	require.Empty(t, retries(ctx))
+	require.Equal(t, sdkmath.NewInt(40), f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()])
+
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(200), history[0].Height)
+
+	_, err = ms.ForceRetry(ctx, &restakingv1.MsgForceRetry{DelegatorAddress: delegator.String(), Id: entry.Id})
+	require.ErrorIs(t, err, types.ErrRetryNotFound)
+}
+
+func TestCancelRetry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	other := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	f.bankKeeper.balances[delegator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 400))
+
+	ctx := f.ctx.WithBlockHeight(5)
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 400))))
+	entry, found := f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.True(t, found)
+
+	// only the delegator owning the entry can cancel it
+	_, err := ms.CancelRetry(ctx, &restakingv1.MsgCancelRetry{DelegatorAddress: other.String(), Id: entry.Id})
+	require.ErrorIs(t, err, types.ErrRetryNotFound)
+
+	_, err = ms.CancelRetry(ctx, &restakingv1.MsgCancelRetry{DelegatorAddress: delegator.String(), Id: entry.Id})
+	require.NoError(t, err)
+	_, found = f.keeper.GetRetryEntry(ctx, delegator, entry.Id)
+	require.False(t, found)
+
+	// a cancelled entry is no longer retried
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(entry.NextAttemptHeight), f.keeper.NewBlockBudget(ctx)))
+	require.Empty(t, f.stakingKeeper.delegations)
+
+	// nothing is queued when retries are disabled
+	params := f.keeper.GetParams(ctx)
+	params.MaxRetryAttempts = 0
+	require.NoError(t, f.keeper.SetParams(ctx, params))
+	missing := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, missing, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 400))))
+	_, found = f.keeper.GetRetryEntry(ctx, delegator, 1)
+	require.False(t, found)
+}
+
+func TestRetryDroppedOnceRewardsAreSpent(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyOneDec()
+	params.RetryBackoffBlocks = 10
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// the validator does not exist yet, so the restake is queued
+	ctx := f.ctx.WithBlockHeight(100)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+	// the delegator spent part of the rewards since, so the rest may well be
+	// other funds: the forced retry is refused and the queued one dropped
+	f.bankKeeper.balances[delegator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 25))
+	_, err := ms.ForceRetry(ctx, &restakingv1.MsgForceRetry{DelegatorAddress: delegator.String(), Id: 0})
+	require.ErrorIs(t, err, types.ErrRetryFailed)
+	require.ErrorContains(t, err, types.ErrRetryObsolete.Error())
+	_, found := f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.True(t, found)
+
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(110), f.keeper.NewBlockBudget(ctx)))
+	_, found = f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.False(t, found)
+	require.Empty(t, f.stakingKeeper.delegations)
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Empty(t, history)
+}
+
+func TestRetryDroppedOnceAutoRestakeIsTurnedOff(t *testing.T) {
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	one := sdkmath.LegacyOneDec()
+
+	for _, tc := range []struct {
+		name string
+		// ratio is the auto_restake_ratio parameter
+		ratio sdkmath.LegacyDec
+		// pref is the preference the restake ran under
+		pref restakingv1.DelegatorPreference
+		// turnOff turns auto-restake off for the delegation
+		turnOff func(f *fixture, ctx sdk.Context)
+	}{
+		{
+			name:  "preference disabled",
+			ratio: one,
+			pref:  restakingv1.DelegatorPreference{DelegatorAddress: delegator.String()},
+			turnOff: func(f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.keeper.SetDelegatorPreference(ctx, restakingv1.DelegatorPreference{
+					DelegatorAddress: delegator.String(),
+					Disabled:         true,
+				}))
+			},
+		},
+		{
+			name:  "preference cleared",
+			ratio: sdkmath.LegacyZeroDec(),
+			pref:  restakingv1.DelegatorPreference{DelegatorAddress: delegator.String(), Ratio: &one},
+			turnOff: func(f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.keeper.DeleteDelegatorPreference(ctx, delegator))
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(&f.keeper)
+
+			params := types.DefaultParams()
+			params.AutoRestakeRatio = tc.ratio
+			params.RetryBackoffBlocks = 10
+			require.NoError(t, f.keeper.SetParams(f.ctx, params))
+			require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, tc.pref))
+
+			// the validator does not exist yet, so the restake is queued
+			ctx := f.ctx.WithBlockHeight(100)
+			rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40))
+			f.bankKeeper.balances[delegator.String()] = rewards
+			require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+			_, found := f.keeper.GetRetryEntry(ctx, delegator, 0)
+			require.True(t, found)
+			f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+
+			tc.turnOff(f, ctx)
+			_, err := ms.ForceRetry(ctx, &restakingv1.MsgForceRetry{DelegatorAddress: delegator.String(), Id: 0})
+			require.ErrorContains(t, err, types.ErrRetryObsolete.Error())
+
+			require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(110), f.keeper.NewBlockBudget(ctx)))
+			_, found = f.keeper.GetRetryEntry(ctx, delegator, 0)
+			require.False(t, found)
+			require.Empty(t, f.stakingKeeper.delegations)
+		})
+	}
+}
+
+func TestPruneFailedRetries(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	params := types.DefaultParams()
+	params.AutoRestakeRatio = sdkmath.LegacyOneDec()
+	params.MaxRetryAttempts = 1
+	params.RetryBackoffBlocks = 10
+	params.FailedRetryRetentionBlocks = 50
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	ctx := f.ctx.WithBlockHeight(100)
+	f.bankKeeper.balances[delegator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, sdk.NewCoins(sdk.NewInt64Coin("ulbt", 40))))
+	require.NoError(t, f.keeper.ProcessRetries(ctx.WithBlockHeight(110), f.keeper.NewBlockBudget(ctx)))
+	entry, found := f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.True(t, found)
+	require.Equal(t, restakingv1.RetryStatus_RETRY_STATUS_FAILED, entry.Status)
+	_, broken := keeper.RetryQueueInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+
+	// the failed entry is kept for the retention period only
+	require.NoError(t, f.keeper.PruneFailedRetries(ctx.WithBlockHeight(159)))
+	_, found = f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.True(t, found)
+	require.NoError(t, f.keeper.PruneFailedRetries(ctx.WithBlockHeight(160)))
+	_, found = f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.False(t, found)
+	_, broken = keeper.RetryQueueInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wraps the keeper to implement the staking module hooks. They
+// tell the reward payouts a delegation change makes on the delegator's behalf
+// apart from withdrawals, and must run before the distribution module's hooks,
+// which make those payouts. They also shrink the restaked shares of a
+// delegation along with it.
+type StakingHooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the hooks to register with the staking module.
+func (k *Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// BeforeDelegationSharesModified marks the delegation change as in progress,
+// so that RecordPayout skips the rewards it pays out.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	if sdkCtx := sdk.UnwrapSDKContext(ctx); recordsPayouts(sdkCtx) {
+		h.k.payouts.delegating = sdkCtx.EventManager()
+	}
+	return nil
+}
+
+// AfterDelegationModified ends the delegation change, and caps the restaked
+// shares of the delegation at the shares left after an undelegation or
+// redelegation.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if recordsPayouts(sdkCtx) {
+		h.k.payouts.delegating = nil
+	}
+
+	// most delegations hold no restaked shares
+	restaked, err := h.k.GetRestakedShares(sdkCtx, delAddr, valAddr)
+	if err != nil || !restaked.IsPositive() {
+		return err
+	}
+	delegation, err := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+	return h.k.capRestakedShares(sdkCtx, delAddr, valAddr, delegation.Shares)
+}
+
+// BeforeDelegationRemoved ends the delegation change of a delegation whose
+// shares are all gone, and forgets its restaked shares.
+func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if recordsPayouts(sdkCtx) {
+		h.k.payouts.delegating = nil
+	}
+	return h.k.capRestakedShares(sdkCtx, delAddr, valAddr, sdkmath.LegacyZeroDec())
+}
+
+// AfterValidatorCreated is a no-op.
+func (h StakingHooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorModified is a no-op.
+func (h StakingHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorRemoved is a no-op.
+func (h StakingHooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBonded is a no-op.
+func (h StakingHooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBeginUnbonding is a no-op.
+func (h StakingHooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationCreated is a no-op; a new delegation has no rewards to pay.
+func (h StakingHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorSlashed is a no-op.
+func (h StakingHooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, sdkmath.LegacyDec) error {
+	return nil
+}
+
+// AfterUnbondingInitiated is a no-op.
+func (h StakingHooks) AfterUnbondingInitiated(context.Context, uint64) error {
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetDelegatorRestaked returns the cumulative amount of bond denom
+// auto-restaked for the delegator.
+func (k Keeper) GetDelegatorRestaked(ctx sdk.Context, delegator sdk.AccAddress) (sdkmath.Int, error) {
+	return getIntOrZero(k.delegatorStats.Get(ctx, delegator))
+}
+
+// GetValidatorRestaked returns the cumulative amount of bond denom
+// auto-restaked to the validator.
+func (k Keeper) GetValidatorRestaked(ctx sdk.Context, validator sdk.ValAddress) (sdkmath.Int, error) {
+	return getIntOrZero(k.validatorStats.Get(ctx, validator))
+}
+
+// GetTotalRestaked returns the cumulative amount of bond denom auto-restaked
+// across the chain.
+func (k Keeper) GetTotalRestaked(ctx sdk.Context) (sdkmath.Int, error) {
+	return getIntOrZero(k.totalRestaked.Get(ctx))
+}
+
+// GetActiveRestakers returns the number of delegators opted in to
+// auto-restaking, that is with a stored preference that is not disabled.
+func (k Keeper) GetActiveRestakers(ctx sdk.Context) (uint64, error) {
+	count, err := k.activeRestakers.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// countActiveRestaker updates the active restaker count when a delegator's
+// preference change opts it in to or out of auto-restaking.
+func (k Keeper) countActiveRestaker(ctx sdk.Context, wasActive, active bool) error {
+	if wasActive == active {
+		return nil
+	}
+
+	count, err := k.GetActiveRestakers(ctx)
+	if err != nil {
+		return err
+	}
+	if active {
+		count++
+	} else {
+		count--
+	}
+	return k.activeRestakers.Set(ctx, count)
+}
+
+// GetRestakedShares returns the shares of the delegator's delegation to the
+// validator that were auto-restaked and are still held.
+func (k Keeper) GetRestakedShares(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdkmath.LegacyDec, error) {
+	shares, err := k.restakedShares.Get(ctx, collections.Join(delegator, validator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// capRestakedShares lowers the restaked shares of the delegation to the shares
+// it still holds, removing them along with the delegation.
+func (k Keeper) capRestakedShares(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, held sdkmath.LegacyDec) error {
+	key := collections.Join(delegator, validator)
+	restaked, err := k.GetRestakedShares(ctx, delegator, validator)
+	if err != nil || restaked.LTE(held) {
+		return err
+	}
+	if !held.IsPositive() {
+		return k.restakedShares.Remove(ctx, key)
+	}
+	return k.restakedShares.Set(ctx, key, held)
+}
+
+// addRestaked adds amount to the delegator, validator and chain-wide totals,
+// and shares, what it delegated, to the restaked shares of the delegation.
+func (k Keeper) addRestaked(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdkmath.Int, shares sdkmath.LegacyDec) error {
+	restakedShares, err := k.GetRestakedShares(ctx, delegator, validator)
+	if err != nil {
+		return err
+	}
+	if err := k.restakedShares.Set(ctx, collections.Join(delegator, validator), restakedShares.Add(shares)); err != nil {
+		return err
+	}
+
+	delegatorTotal, err := k.GetDelegatorRestaked(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if err := k.delegatorStats.Set(ctx, delegator, delegatorTotal.Add(amount)); err != nil {
+		return err
+	}
+
+	validatorTotal, err := k.GetValidatorRestaked(ctx, validator)
+	if err != nil {
+		return err
+	}
+	if err := k.validatorStats.Set(ctx, validator, validatorTotal.Add(amount)); err != nil {
+		return err
+	}
+
+	total, err := k.GetTotalRestaked(ctx)
+	if err != nil {
+		return err
+	}
+	return k.totalRestaked.Set(ctx, total.Add(amount))
+}
+
+func getIntOrZero(amount sdkmath.Int, err error) (sdkmath.Int, error) {
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt(), nil
+	}
+	return amount, err
+}
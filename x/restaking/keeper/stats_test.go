@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+)
+
+func TestRestakeStats(t *testing.T) {
+	f := initFixture(t)
+
+	alice := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	bob := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	other := sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20))
+	missing := sdk.ValAddress(bytes.Repeat([]byte{0x5}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: validator.String()})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: other.String()})
+	require.NoError(t, f.keeper.SetAutoRestakeRatio(f.ctx, sdkmath.LegacyOneDec()))
+	for _, delegator := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+			DelegatorAddress: delegator.String(),
+		}))
+	}
+
+	rewards := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("ulbt", amount)) }
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, alice, validator, rewards(100)))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, alice, other, rewards(50)))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, bob, validator, rewards(10)))
+	// failed restakes are not counted
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, bob, missing, rewards(1_000)))
+
+	qs := keeper.NewQueryServer(&f.keeper)
+
+	delRes, err := qs.DelegatorStats(f.ctx, &restakingv1.QueryDelegatorStatsRequest{DelegatorAddress: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 150), delRes.Restaked)
+
+	valRes, err := qs.ValidatorStats(f.ctx, &restakingv1.QueryValidatorStatsRequest{ValidatorAddress: validator.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 110), valRes.Restaked)
+
+	valRes, err = qs.ValidatorStats(f.ctx, &restakingv1.QueryValidatorStatsRequest{ValidatorAddress: missing.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulbt", 0), valRes.Restaked)
+
+	res, err := qs.Stats(f.ctx, &restakingv1.QueryStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &restakingv1.QueryStatsResponse{
+		TotalRestaked:   sdk.NewInt64Coin("ulbt", 160),
+		ActiveRestakers: 2,
+	}, res)
+
+	_, err = qs.DelegatorStats(f.ctx, &restakingv1.QueryDelegatorStatsRequest{DelegatorAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestActiveRestakers(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
+
+	alice := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	bob := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	setPreference := func(delegator sdk.AccAddress, disabled bool) {
+		_, err := ms.SetDelegatorPreference(f.ctx, &restakingv1.MsgSetDelegatorPreference{
+			DelegatorAddress: delegator.String(),
+			Disabled:         disabled,
+		})
+		require.NoError(t, err)
+	}
+	requireActive := func(expected uint64) {
+		t.Helper()
+		count, err := f.keeper.GetActiveRestakers(f.ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, count)
+		_, broken := keeper.RestakeStatisticsInvariant(f.keeper)(f.ctx)
+		require.False(t, broken)
+	}
+
+	// opting in counts a delegator once, however often it is repeated
+	setPreference(alice, false)
+	setPreference(alice, false)
+	setPreference(bob, false)
+	requireActive(2)
+
+	// a disabled preference opts out, and so does clearing it
+	setPreference(bob, true)
+	requireActive(1)
+	_, err := ms.ClearDelegatorPreference(f.ctx, &restakingv1.MsgClearDelegatorPreference{DelegatorAddress: bob.String()})
+	require.NoError(t, err)
+	requireActive(1)
+	_, err = ms.ClearDelegatorPreference(f.ctx, &restakingv1.MsgClearDelegatorPreference{DelegatorAddress: alice.String()})
+	require.NoError(t, err)
+	requireActive(0)
+
+	setPreference(bob, false)
+	requireActive(1)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// unhealthyReason says why val must not be restaked to, or returns "" when it
+// may be. A validator is unhealthy when it is jailed, not bonded, charges more
+// than max_validator_commission or signed a smaller share of the slashing
+// window than min_validator_uptime.
+func (k Keeper) unhealthyReason(ctx sdk.Context, val stakingtypes.Validator) (string, error) {
+	params := k.GetParams(ctx)
+
+	// tombstoned validators stay jailed for good, so they fail the first check
+	switch {
+	case val.IsJailed():
+		return "jailed", nil
+	case !val.IsBonded():
+		return "not bonded", nil
+	case val.Commission.Rate.GT(params.MaxValidatorCommission):
+		return fmt.Sprintf("commission %s above %s", val.Commission.Rate, params.MaxValidatorCommission), nil
+	case params.MinValidatorUptime.IsZero():
+		return "", nil
+	}
+
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return "", err
+	}
+	info, err := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if errors.Is(err, slashingtypes.ErrNoSigningInfoFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	window, err := k.slashingKeeper.SignedBlocksWindow(ctx)
+	if err != nil {
+		return "", err
+	}
+	if window <= 0 {
+		return "", nil
+	}
+
+	uptime := sdkmath.LegacyOneDec().Sub(sdkmath.LegacyNewDec(info.MissedBlocksCounter).QuoInt64(window))
+	if uptime.LT(params.MinValidatorUptime) {
+		return fmt.Sprintf("uptime %s below %s", uptime, params.MinValidatorUptime), nil
+	}
+	return "", nil
+}
+
+// restakeTarget applies the unhealthy validator policy to rewards paid by
+// validator. It returns the validator to restake to, which is validator itself
+// while it is healthy. Otherwise it emits EventUnhealthyValidator and returns
+// the delegator's fallback validator under the redirect policy, or nil along
+// with the policy that applies instead. Delegators with diversification
+// targets never restake to validator, so no policy applies to them.
+func (k Keeper) restakeTarget(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.ValAddress, restakingv1.UnhealthyValidatorPolicy, error) {
+	if pref, found := k.GetDelegatorPreference(ctx, delegator); found && len(pref.DiversificationTargets) > 0 {
+		return validator, restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED, nil
+	}
+
+	val, err := k.stakingKeeper.GetValidator(ctx, validator)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		// left for the restake itself to fail on
+		return validator, restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reason, err := k.unhealthyReason(ctx, val)
+	if err != nil {
+		return nil, 0, err
+	}
+	if reason == "" {
+		return validator, restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED, nil
+	}
+
+	policy := k.GetParams(ctx).UnhealthyValidatorPolicy
+	var target sdk.ValAddress
+	if policy == restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_REDIRECT {
+		target, err = k.fallbackValidator(ctx, delegator, validator)
+		if err != nil {
+			return nil, 0, err
+		}
+		if target == nil {
+			policy = restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR
+		}
+	}
+
+	event := &restakingv1.EventUnhealthyValidator{
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Reason:    reason,
+		Policy:    policy,
+		Height:    ctx.BlockHeight(),
+	}
+	if target != nil {
+		event.RedirectedTo = target.String()
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return nil, 0, err
+	}
+
+	return target, policy, nil
+}
+
+// fallbackValidator returns the delegator's fallback validator when it is set,
+// differs from validator and is healthy itself.
+func (k Keeper) fallbackValidator(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.ValAddress, error) {
+	pref, found := k.GetDelegatorPreference(ctx, delegator)
+	if !found || pref.FallbackValidatorAddress == "" || pref.FallbackValidatorAddress == validator.String() {
+		return nil, nil
+	}
+
+	fallback, err := sdk.ValAddressFromBech32(pref.FallbackValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	val, err := k.stakingKeeper.GetValidator(ctx, fallback)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := k.unhealthyReason(ctx, val)
+	if err != nil || reason != "" {
+		return nil, err
+	}
+	return fallback, nil
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func setUnhealthyValidatorPolicy(t *testing.T, f *fixture, policy restakingv1.UnhealthyValidatorPolicy) {
+	t.Helper()
+	params := f.keeper.GetParams(f.ctx)
+	params.UnhealthyValidatorPolicy = policy
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+}
+
+func unhealthyValidatorEvents(t *testing.T, ctx sdk.Context) []*restakingv1.EventUnhealthyValidator {
+	t.Helper()
+	var events []*restakingv1.EventUnhealthyValidator
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if e, ok := msg.(*restakingv1.EventUnhealthyValidator); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func TestUnhealthyValidatorSkip(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	jailed := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	unbonding := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: jailed.String(), Jailed: true})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: unbonding.String(), Status: stakingtypes.Unbonding})
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	ctx := f.ctx.WithBlockHeight(7).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, jailed, rewards))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, unbonding, rewards))
+
+	// nothing is delegated, recorded or queued for retry
+	require.Empty(t, f.stakingKeeper.delegations)
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Empty(t, history)
+	_, found := f.keeper.GetRetryEntry(ctx, delegator, 0)
+	require.False(t, found)
+
+	require.Equal(t, []*restakingv1.EventUnhealthyValidator{
+		{
+			Delegator: delegator.String(),
+			Validator: jailed.String(),
+			Reason:    "jailed",
+			Policy:    restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SKIP,
+			Height:    7,
+		},
+		{
+			Delegator: delegator.String(),
+			Validator: unbonding.String(),
+			Reason:    "not bonded",
+			Policy:    restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SKIP,
+			Height:    7,
+		},
+	}, unhealthyValidatorEvents(t, ctx))
+
+	// the guard also holds for direct restakes, such as retries
+	err = f.keeper.RestakeDelegate(ctx, delegator, jailed, rewards)
+	require.ErrorIs(t, err, types.ErrValidatorUnhealthy)
+}
+
+func TestUnhealthyValidatorRedirect(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	jailed := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	fallback := sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: jailed.String(), Jailed: true})
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: fallback.String()})
+	setUnhealthyValidatorPolicy(t, f, restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_REDIRECT)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+
+	// without a fallback the rewards stay with the delegator
+	ctx := f.ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, jailed, rewards))
+	require.Empty(t, f.stakingKeeper.delegations)
+	events := unhealthyValidatorEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR, events[0].Policy)
+
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress:         delegator.String(),
+		FallbackValidatorAddress: fallback.String(),
+	}))
+
+	ctx = f.ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, jailed, rewards))
+	require.Equal(t, sdkmath.NewInt(250), f.stakingKeeper.delegations[delegator.String()+"|"+fallback.String()])
+
+	history, err := f.keeper.GetRestakeHistory(ctx, delegator)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, fallback.String(), history[0].ValidatorAddress)
+	require.Equal(t, jailed.String(), history[0].RedirectedFrom)
+
+	events = unhealthyValidatorEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, fallback.String(), events[0].RedirectedTo)
+
+	// an unhealthy fallback is not redirected to
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: fallback.String(), Jailed: true})
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, jailed, rewards))
+	require.Equal(t, sdkmath.NewInt(250), f.stakingKeeper.delegations[delegator.String()+"|"+fallback.String()])
+}
+
+func TestUnhealthyValidatorSendToDelegatorInEpochMode(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	jailed := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	f.stakingKeeper.addValidator(stakingtypes.Validator{OperatorAddress: jailed.String(), Jailed: true})
+	f.stakingKeeper.delegations[delegator.String()+"|"+jailed.String()] = sdkmath.NewInt(1_000)
+	f.distrKeeper.setRewards(delegator, jailed, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", 400)))
+
+	params := f.keeper.GetParams(f.ctx)
+	params.EpochIdentifier = "day"
+	params.UnhealthyValidatorPolicy = restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SEND_TO_DELEGATOR
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.SetDelegatorPreference(f.ctx, restakingv1.DelegatorPreference{
+		DelegatorAddress: delegator.String(),
+	}))
+
+	// the rewards are withdrawn but not restaked
+	endEpoch(t, f, "day", 1)
+	require.Empty(t, f.distrKeeper.rewards)
+	require.Equal(t, sdkmath.NewInt(1_000), f.stakingKeeper.delegations[delegator.String()+"|"+jailed.String()])
+
+	// under the skip policy they keep accruing instead
+	f.distrKeeper.setRewards(delegator, jailed, sdk.NewDecCoins(sdk.NewInt64DecCoin("ulbt", 400)))
+	setUnhealthyValidatorPolicy(t, f, restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SKIP)
+	endEpoch(t, f, "day", 2)
+	require.Len(t, f.distrKeeper.rewards, 1)
+}
+
+func TestUnhealthyValidatorThresholds(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	pubKey := ed25519.GenPrivKey().PubKey()
+	val, err := stakingtypes.NewValidator(validator.String(), pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	val.Status = stakingtypes.Bonded
+	val.Commission.Rate = sdkmath.LegacyMustNewDecFromStr("0.2")
+	f.stakingKeeper.addValidator(val)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	delegated := func() sdkmath.Int {
+		amt, ok := f.stakingKeeper.delegations[delegator.String()+"|"+validator.String()]
+		if !ok {
+			return sdkmath.ZeroInt()
+		}
+		return amt
+	}
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxValidatorCommission = sdkmath.LegacyMustNewDecFromStr("0.1")
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, delegator, validator, rewards))
+	require.True(t, delegated().IsZero())
+
+	// validators without signing info are not held to the uptime threshold
+	params.MaxValidatorCommission = sdkmath.LegacyOneDec()
+	params.MinValidatorUptime = sdkmath.LegacyMustNewDecFromStr("0.9")
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, delegator, validator, rewards))
+	require.Equal(t, sdkmath.NewInt(250), delegated())
+
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	f.slashKeeper.signingInfos[consAddr.String()] = slashingtypes.ValidatorSigningInfo{
+		Address:             consAddr.String(),
+		MissedBlocksCounter: 11,
+	}
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ExecuteAutoRestake(ctx, delegator, validator, rewards))
+	require.Equal(t, sdkmath.NewInt(250), delegated())
+	events := unhealthyValidatorEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Contains(t, events[0].Reason, "uptime")
+
+	f.slashKeeper.signingInfos[consAddr.String()] = slashingtypes.ValidatorSigningInfo{
+		Address:             consAddr.String(),
+		MissedBlocksCounter: 10,
+	}
+	require.NoError(t, f.keeper.ExecuteAutoRestake(f.ctx, delegator, validator, rewards))
+	require.Equal(t, sdkmath.NewInt(500), delegated())
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// GetValidatorOverride returns the auto-restake ratio override of a validator, if any.
+func (k Keeper) GetValidatorOverride(ctx sdk.Context, validator sdk.ValAddress) (sdkmath.LegacyDec, bool) {
+	ratio, err := k.validatorOverrides.Get(ctx, validator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdkmath.LegacyDec{}, false
+		}
+		panic(err)
+	}
+	return ratio, true
+}
+
+// SetValidatorOverride stores a validator override after checking it against the
+// governance min/max bounds.
+func (k Keeper) SetValidatorOverride(ctx sdk.Context, validator sdk.ValAddress, ratio sdkmath.LegacyDec) error {
+	if err := types.ValidateValidatorRatio(k.GetParams(ctx), ratio); err != nil {
+		return err
+	}
+	return k.validatorOverrides.Set(ctx, validator, ratio)
+}
+
+// DeleteValidatorOverride removes a validator override.
+func (k Keeper) DeleteValidatorOverride(ctx sdk.Context, validator sdk.ValAddress) error {
+	return k.validatorOverrides.Remove(ctx, validator)
+}
+
+// validatorRatio returns the ratio applied to rewards paid by validator when the
+// delegator has no preference, with its source: the validator's override
+// clamped to the current governance bounds, or the global ratio.
+func (k Keeper) validatorRatio(ctx sdk.Context, validator sdk.ValAddress) (sdkmath.LegacyDec, restakingv1.RatioSource) {
+	params := k.GetParams(ctx)
+
+	ratio, found := k.GetValidatorOverride(ctx, validator)
+	if !found {
+		return params.AutoRestakeRatio, restakingv1.RatioSource_RATIO_SOURCE_PARAMS
+	}
+
+	// bounds may have been tightened by governance after the override was set
+	if ratio.LT(params.MinValidatorRatio) {
+		ratio = params.MinValidatorRatio
+	}
+	if ratio.GT(params.MaxValidatorRatio) {
+		ratio = params.MaxValidatorRatio
+	}
+	return ratio, restakingv1.RatioSource_RATIO_SOURCE_VALIDATOR_OVERRIDE
+}
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// payoutLog is the in-memory side of the reward payouts recorded during the
+// transaction being executed. For every payout it remembers the event manager
+// it was made under and how many events that manager held at the time:
+// distribution emits the withdraw_rewards event naming the delegation right
+// after paying its rewards out, which is how CommitWithdrawals tells which
+// delegation a payout belongs to. The payouts themselves are kept in the
+// store, so that only those whose message succeeded are looked up here.
+//
+// Only transactions being finalized touch the log, one at a time. It is reset
+// as each of them starts, since a transaction that fails never reaches the
+// post handler committing its withdrawals.
+type payoutLog struct {
+	next  uint64
+	marks map[uint64]payoutMark
+
+	// delegating is the event manager of the delegation change in progress,
+	// whose own reward payout is not a withdrawal
+	delegating sdk.EventManagerI
+}
+
+type payoutMark struct {
+	events sdk.EventManagerI
+	offset int
+}
+
+func newPayoutLog() *payoutLog {
+	return &payoutLog{marks: make(map[uint64]payoutMark)}
+}
+
+func (l *payoutLog) reset() {
+	l.next = 0
+	l.delegating = nil
+	clear(l.marks)
+}
+
+var distrModuleAddr = authtypes.NewModuleAddress(distributiontypes.ModuleName)
+
+// withdrawalEventTypes are the events a message may emit besides its reward
+// withdrawals for them to be auto-restaked. Anything else, e.g. a
+// claim-and-restake that already delegates the rewards itself, leaves the
+// message's payouts alone.
+var withdrawalEventTypes = map[string]bool{
+	distributiontypes.EventTypeWithdrawRewards:    true,
+	distributiontypes.EventTypeSetWithdrawAddress: true,
+	banktypes.EventTypeCoinSpent:                  true,
+	banktypes.EventTypeCoinReceived:               true,
+	banktypes.EventTypeTransfer:                   true,
+	banktypes.EventTypeCoinMint:                   true,
+	banktypes.EventTypeCoinBurn:                   true,
+	sdk.EventTypeMessage:                          true,
+}
+
+// recordsPayouts reports whether ctx executes a transaction's messages, the
+// only place reward withdrawals are captured in.
+func recordsPayouts(ctx sdk.Context) bool {
+	return ctx.ExecMode() == sdk.ExecModeFinalize && len(ctx.TxBytes()) > 0
+}
+
+// ResetPayouts forgets the payouts recorded by earlier transactions. It runs
+// before every transaction, ahead of the ante handler.
+func (k Keeper) ResetPayouts(ctx sdk.Context) {
+	if recordsPayouts(ctx) {
+		k.payouts.reset()
+	}
+}
+
+// RecordPayout is a bank send restriction recording every payout the
+// distribution module makes while a transaction's messages execute, whether
+// the withdrawal was a message of its own or ran through authz. It never
+// changes nor blocks the send. Payouts made while a delegation
+// changes are not withdrawals and are skipped, as is everything in epoch mode.
+func (k Keeper) RecordPayout(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !recordsPayouts(ctx) || !fromAddr.Equals(distrModuleAddr) || amt.IsZero() {
+		return toAddr, nil
+	}
+	if k.payouts.delegating != nil && k.payouts.delegating == ctx.EventManager() {
+		return toAddr, nil
+	}
+	if k.EpochMode(ctx) {
+		return toAddr, nil
+	}
+
+	recipient, err := k.addressCodec.BytesToString(toAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	seq := k.payouts.next
+	k.payouts.next++
+	if err := k.pendingPayouts.Set(ctx, seq, restakingv1.RewardWithdrawal{
+		DelegatorAddress: recipient,
+		Amount:           amt,
+	}); err != nil {
+		return nil, err
+	}
+	k.payouts.marks[seq] = payoutMark{events: ctx.EventManager(), offset: len(ctx.EventManager().Events())}
+
+	return toAddr, nil
+}
+
+// CommitWithdrawals moves the reward withdrawals the current transaction paid
+// out to the delegators' own accounts into the queue processed at end block.
+// It runs once the transaction's messages have succeeded, so that only the
+// payouts that were not reverted are left in the store.
+func (k Keeper) CommitWithdrawals(ctx sdk.Context) error {
+	defer k.payouts.reset()
+
+	var payouts []collections.KeyValue[uint64, restakingv1.RewardWithdrawal]
+	if err := k.pendingPayouts.Walk(ctx, nil, func(seq uint64, p restakingv1.RewardWithdrawal) (bool, error) {
+		payouts = append(payouts, collections.KeyValue[uint64, restakingv1.RewardWithdrawal]{Key: seq, Value: p})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, kv := range payouts {
+		if err := k.pendingPayouts.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+
+		mark, ok := k.payouts.marks[kv.Key]
+		if !ok {
+			continue
+		}
+		w, ok := matchWithdrawal(mark, kv.Value)
+		if !ok {
+			continue
+		}
+
+		if err := k.queueWithdrawal(ctx, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchWithdrawal pairs a payout with the withdraw_rewards event distribution
+// emitted after making it. It only succeeds when the rewards went to the
+// delegator's own account and nothing but withdrawals happened under the same
+// event manager.
+func matchWithdrawal(mark payoutMark, payout restakingv1.RewardWithdrawal) (restakingv1.RewardWithdrawal, bool) {
+	events := mark.events.Events()
+	if mark.offset > len(events) {
+		return restakingv1.RewardWithdrawal{}, false
+	}
+	for _, ev := range events {
+		if !withdrawalEventTypes[ev.Type] {
+			return restakingv1.RewardWithdrawal{}, false
+		}
+	}
+
+	for _, ev := range events[mark.offset:] {
+		if ev.Type != distributiontypes.EventTypeWithdrawRewards {
+			continue
+		}
+
+		var w restakingv1.RewardWithdrawal
+		var amount string
+		for _, attr := range ev.Attributes {
+			switch attr.Key {
+			case distributiontypes.AttributeKeyDelegator:
+				w.DelegatorAddress = attr.Value
+			case distributiontypes.AttributeKeyValidator:
+				w.ValidatorAddress = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		if w.DelegatorAddress != payout.DelegatorAddress || amount != payout.Amount.String() {
+			return restakingv1.RewardWithdrawal{}, false
+		}
+		w.Amount = payout.Amount
+		return w, true
+	}
+
+	return restakingv1.RewardWithdrawal{}, false
+}
+
+// ProcessWithdrawals auto-restakes the queued withdrawals, in the order they
+// were committed, until the block budget runs out. Whatever the budget leaves
+// over stays queued for the next block.
+func (k Keeper) ProcessWithdrawals(ctx sdk.Context, budget *BlockBudget) error {
+	remaining := budget.Remaining()
+	if remaining == 0 {
+		return nil
+	}
+
+	var queued []collections.KeyValue[uint64, restakingv1.RewardWithdrawal]
+	if err := k.deferred.Walk(ctx, nil, func(seq uint64, w restakingv1.RewardWithdrawal) (bool, error) {
+		queued = append(queued, collections.KeyValue[uint64, restakingv1.RewardWithdrawal]{Key: seq, Value: w})
+		return len(queued) >= int(remaining), nil
+	}); err != nil {
+		return err
+	}
+
+	for _, kv := range queued {
+		if budget.Exhausted() {
+			break
+		}
+		if err := k.restakeWithdrawal(ctx, budget, kv.Value); err != nil {
+			return err
+		}
+		if err := k.deferred.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restakeWithdrawal auto-restakes a single withdrawal, charging it to the
+// budget.
+func (k Keeper) restakeWithdrawal(ctx sdk.Context, budget *BlockBudget, w restakingv1.RewardWithdrawal) error {
+	delAddr, err := sdk.AccAddressFromBech32(w.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(w.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	return budget.run(ctx, func(ctx sdk.Context) error {
+		return k.ExecuteAutoRestake(ctx, delAddr, valAddr, w.Amount)
+	})
+}
+
+// queueWithdrawal appends a withdrawal to the queue processed at end block.
+func (k Keeper) queueWithdrawal(ctx sdk.Context, w restakingv1.RewardWithdrawal) error {
+	seq, err := k.deferredSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	return k.deferred.Set(ctx, seq, w)
+}
+
+// IterateDeferredWithdrawals calls cb for every withdrawal waiting to be
+// auto-restaked at end block, in processing order, until cb returns true.
+func (k Keeper) IterateDeferredWithdrawals(ctx sdk.Context, cb func(restakingv1.RewardWithdrawal) (stop bool)) error {
+	return k.deferred.Walk(ctx, nil, func(_ uint64, w restakingv1.RewardWithdrawal) (bool, error) {
+		return cb(w), nil
+	})
+}
+
+// pendingRewards returns the rewards a withdrawal would currently pay the
+// delegator, mirroring the distribution module's own computation.
+func (k Keeper) pendingRewards(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.Coins, error) {
+	// ending the validator period writes state, so work on a throwaway branch
+	cacheCtx, _ := ctx.CacheContext()
+
+	val, err := k.stakingKeeper.Validator(cacheCtx, validator)
+	if err != nil {
+		return nil, err
+	}
+	del, err := k.stakingKeeper.Delegation(cacheCtx, delegator, validator)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil || del == nil {
+		return nil, nil
+	}
+
+	endingPeriod, err := k.distrKeeper.IncrementValidatorPeriod(cacheCtx, val)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := k.distrKeeper.CalculateDelegationRewards(cacheCtx, val, del, endingPeriod)
+	if err != nil {
+		return nil, err
+	}
+	outstanding, err := k.distrKeeper.GetValidatorOutstandingRewardsCoins(cacheCtx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, _ := rewards.Intersect(outstanding).TruncateDecimal()
+	return coins, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+func collectWithdrawals(t *testing.T, f *fixture) []restakingv1.RewardWithdrawal {
+	t.Helper()
+
+	var withdrawals []restakingv1.RewardWithdrawal
+	require.NoError(t, f.keeper.IterateDeferredWithdrawals(f.ctx, func(w restakingv1.RewardWithdrawal) bool {
+		withdrawals = append(withdrawals, w)
+		return false
+	}))
+	return withdrawals
+}
+
+// txContext returns a context executing the messages of a transaction in a
+// finalized block, with an event manager of its own.
+func txContext(f *fixture) sdk.Context {
+	return f.ctx.WithExecMode(sdk.ExecModeFinalize).WithTxBytes([]byte("tx")).WithEventManager(sdk.NewEventManager())
+}
+
+// payOut pays out the rewards of a delegation to recipient the way the
+// distribution module does: through the bank send restriction, followed by
+// the withdraw_rewards event.
+func payOut(t testing.TB, f *fixture, ctx sdk.Context, delegator, recipient sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) {
+	t.Helper()
+
+	distrAddr := authtypes.NewModuleAddress(distributiontypes.ModuleName)
+	to, err := f.keeper.RecordPayout(ctx, distrAddr, recipient, amount)
+	require.NoError(t, err)
+	require.Equal(t, recipient, to)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, distrAddr.String())),
+		sdk.NewEvent(
+			distributiontypes.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(distributiontypes.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(distributiontypes.AttributeKeyDelegator, delegator.String()),
+		),
+	})
+}
+
+func TestRecordAndCommitWithdrawals(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	other := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	thirdParty := sdk.AccAddress(bytes.Repeat([]byte{0x5}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+
+	// one message withdraws the delegator's rewards, another those of other,
+	// which are paid to a third party and are not restaked
+	ctx := txContext(f)
+	payOut(t, f, ctx, delegator, delegator, validator, rewards)
+	payOut(t, f, ctx.WithEventManager(sdk.NewEventManager()), other, thirdParty, validator, rewards)
+
+	// nothing is queued until the transaction is committed
+	require.Empty(t, collectWithdrawals(t, f))
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+	require.Equal(t, []restakingv1.RewardWithdrawal{{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Amount:           rewards,
+	}}, collectWithdrawals(t, f))
+
+	// a reverted payout, e.g. of a failed message, leaves no trace
+	cacheCtx, _ := txContext(f).CacheContext()
+	payOut(t, f, cacheCtx, delegator, delegator, validator, rewards)
+	require.NoError(t, f.keeper.CommitWithdrawals(txContext(f)))
+	require.Len(t, collectWithdrawals(t, f), 1)
+
+	// sends by other accounts, and payouts outside of a transaction, are not
+	// recorded
+	_, err := f.keeper.RecordPayout(txContext(f), other, delegator, rewards)
+	require.NoError(t, err)
+	payOut(t, f, f.ctx.WithEventManager(sdk.NewEventManager()), delegator, delegator, validator, rewards)
+	require.NoError(t, f.keeper.CommitWithdrawals(txContext(f)))
+	require.Len(t, collectWithdrawals(t, f), 1)
+}
+
+func TestCommitWithdrawalsSkipsDelegationChanges(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	hooks := f.keeper.StakingHooks()
+
+	// topping up a delegation pays its rewards out on the delegator's behalf
+	ctx := txContext(f)
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delegator, validator))
+	payOut(t, f, ctx, delegator, delegator, validator, rewards)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delegator, validator))
+
+	// a message that restakes the rewards itself is left alone as well
+	claimCtx := txContext(f)
+	payOut(t, f, claimCtx, delegator, delegator, validator, rewards)
+	claimCtx.EventManager().EmitEvent(sdk.NewEvent("claim_and_restake"))
+
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+	require.Empty(t, collectWithdrawals(t, f))
+
+	// once the delegation change is over, withdrawals are recorded again
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delegator, validator))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, delegator, validator))
+	payOut(t, f, ctx, delegator, delegator, validator, rewards)
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+	require.Len(t, collectWithdrawals(t, f), 1)
+}
+
+func TestResetPayoutsForgetsFailedTransactions(t *testing.T) {
+	f := initFixture(t)
+
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ulbt", 1_000))
+	hooks := f.keeper.StakingHooks()
+
+	// a transaction fails halfway through a delegation change, so that
+	// neither the change ends nor the post handler runs
+	failedCtx, _ := txContext(f).CacheContext()
+	require.NoError(t, hooks.BeforeDelegationSharesModified(failedCtx, delegator, validator))
+	payOut(t, f, failedCtx, delegator, delegator, validator, rewards)
+
+	// the next transaction starts over, whatever event manager it runs under
+	ctx := failedCtx.WithMultiStore(f.ctx.MultiStore())
+	f.keeper.ResetPayouts(ctx)
+	payOut(t, f, ctx, delegator, delegator, validator, rewards)
+	require.NoError(t, f.keeper.CommitWithdrawals(ctx))
+	require.Equal(t, []restakingv1.RewardWithdrawal{{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Amount:           rewards,
+	}}, collectWithdrawals(t, f))
+}
@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// AutoRestakeRatioKey is the v1 item holding the global auto-restake ratio,
+// the only state the module kept before it had params.
+var AutoRestakeRatioKey = collections.NewPrefix("auto_restake_ratio")
+
+// MigrateStore performs in-place store migrations from v1 to v2. The v1
+// auto-restake ratio moves into the module params, which take their defaults
+// for every other field.
+func MigrateStore(ctx sdk.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	legacyRatio := collections.NewItem(sb, AutoRestakeRatioKey, "auto_restake_ratio", sdk.LegacyDecValue)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[restakingv1.Params](cdc))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	params := types.DefaultParams()
+	ratio, err := legacyRatio.Get(ctx)
+	switch {
+	case err == nil:
+		params.AutoRestakeRatio = ratio
+		if err := legacyRatio.Remove(ctx); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := types.ValidateParams(params); err != nil {
+		return err
+	}
+	return paramsItem.Set(ctx, params)
+}
@@ -0,0 +1,53 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	v2 "github.com/lyfeloopinc/lyfebloc-network/x/restaking/migrations/v2"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	sb := collections.NewSchemaBuilder(storeService)
+	legacyRatio := collections.NewItem(sb, v2.AutoRestakeRatioKey, "auto_restake_ratio", sdk.LegacyDecValue)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[restakingv1.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// a v1 store holds nothing but the ratio
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	ratio := sdkmath.LegacyMustNewDecFromStr("0.4")
+	require.NoError(t, legacyRatio.Set(ctx, ratio))
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	expected := types.DefaultParams()
+	expected.AutoRestakeRatio = ratio
+	migrated, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, migrated)
+	has, err := legacyRatio.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a v1 store that never set the ratio migrates to the defaults
+	require.NoError(t, params.Remove(ctx))
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+	migrated, err = params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), migrated)
+}
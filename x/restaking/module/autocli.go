@@ -0,0 +1,146 @@
+package module
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. Signers are
+// never positional, so transactions take them from --from.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: restakingv1.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module, including the auto-restake ratio",
+				},
+				{
+					RpcMethod:      "ValidatorOverride",
+					Use:            "validator-override [validator]",
+					Short:          "Shows the auto-restake ratio override of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod: "ValidatorOverrides",
+					Use:       "validator-overrides",
+					Short:     "Lists the auto-restake ratio overrides of all validators",
+				},
+				{
+					RpcMethod:      "DelegatorPreference",
+					Use:            "delegator-preference [delegator]",
+					Short:          "Shows the auto-restake preference of a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}},
+				},
+				{
+					RpcMethod:      "RestakeHistory",
+					Use:            "history [delegator]",
+					Short:          "Lists the auto-restakes executed for a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}},
+				},
+				{
+					RpcMethod:      "DelegatorStats",
+					Use:            "delegator-stats [delegator]",
+					Short:          "Shows the total amount auto-restaked for a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}},
+				},
+				{
+					RpcMethod:      "ValidatorStats",
+					Use:            "validator-stats [validator]",
+					Short:          "Shows the total amount auto-restaked to a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod: "Stats",
+					Use:       "stats",
+					Short:     "Shows the chain-wide auto-restake totals",
+				},
+				{
+					RpcMethod:      "RetryEntries",
+					Use:            "retries [delegator]",
+					Short:          "Lists the failed auto-restakes queued for retry for a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}},
+				},
+				{
+					RpcMethod: "SplitPreview",
+					Use:       "split-preview [delegator] [validator] [rewards]",
+					Short:     "Previews how rewards paid by a validator would be restaked",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "validator_address"},
+						{ProtoField: "rewards"},
+					},
+				},
+				{
+					RpcMethod: "SimulateAutoRestake",
+					Use:       "simulate [delegator] [validator]",
+					Short:     "Simulates withdrawing and auto-restaking the rewards of a delegation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod:      "CarryOvers",
+					Use:            "carry-overs [delegator]",
+					Short:          "Lists the auto-restakes carried over for a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: restakingv1.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "SetValidatorOverride",
+					Use:            "set-validator-override [ratio]",
+					Short:          "Sets the auto-restake ratio of the validator operated by --from",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "ratio"}},
+				},
+				{
+					RpcMethod: "ClearValidatorOverride",
+					Use:       "clear-validator-override",
+					Short:     "Clears the auto-restake ratio override of the validator operated by --from",
+				},
+				{
+					RpcMethod: "SetDelegatorPreference",
+					Use:       "set-preference",
+					Short:     "Sets the auto-restake preference of the --from delegator",
+					Long: "Sets the auto-restake preference of the --from delegator, replacing any previous one. " +
+						"Per-validator preferences and diversification targets are given as JSON, e.g. " +
+						`--diversification-targets '{"validator_address":"...","weight":1}'.`,
+				},
+				{
+					RpcMethod: "ClearDelegatorPreference",
+					Use:       "clear-preference",
+					Short:     "Clears the auto-restake preference of the --from delegator",
+				},
+				{
+					RpcMethod:      "CancelRetry",
+					Use:            "cancel-retry [id]",
+					Short:          "Drops a failed auto-restake of the --from delegator from the retry queue",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ForceRetry",
+					Use:            "force-retry [id]",
+					Short:          "Retries a failed auto-restake of the --from delegator now",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ClaimCarryOver",
+					Use:            "claim-carry-over [validator]",
+					Short:          "Claims back the carry-over of the --from delegator, for one validator or all of them",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address", Optional: true}},
+				},
+			},
+		},
+	}
+}
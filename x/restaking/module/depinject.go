@@ -1,11 +1,20 @@
 package module
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	restakingmodpb "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/module/v1"
 	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
@@ -20,30 +29,85 @@ func init() {
 	appconfig.Register(
 		&restakingmodpb.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetRestakingHooks),
 	)
 }
 
 type ModuleInputs struct {
 	depinject.In
 
-	StoreService  store.KVStoreService
-	Cdc           codec.Codec
-	StakingKeeper types.StakingKeeper
+	Config             *restakingmodpb.Module
+	StoreService       store.KVStoreService
+	Cdc                codec.Codec
+	AddressCodec       address.Codec
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	StakingKeeper      types.StakingKeeper
+	DistributionKeeper types.DistributionKeeper
+	SlashingKeeper     types.SlashingKeeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	RestakingKeeper keeper.Keeper
+	RestakingKeeper *keeper.Keeper
 	Module          appmodule.AppModule
+	EpochHooks      epochstypes.EpochHooksWrapper
+	StakingHooks    stakingtypes.StakingHooksWrapper
+	SendRestriction banktypes.SendRestrictionFn
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.StoreService, in.StakingKeeper)
-	m := NewAppModule(k)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(
+		in.StoreService,
+		in.Cdc,
+		in.AddressCodec,
+		authority,
+		in.StakingKeeper,
+		in.DistributionKeeper,
+		in.SlashingKeeper,
+		in.BankKeeper,
+	)
+	m := NewAppModule(&k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper)
 
 	return ModuleOutputs{
-		RestakingKeeper: k,
+		RestakingKeeper: &k,
 		Module:          m,
+		EpochHooks:      epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
+		StakingHooks:    stakingtypes.StakingHooksWrapper{StakingHooks: k.StakingHooks()},
+		SendRestriction: k.RecordPayout,
+	}
+}
+
+// InvokeSetRestakingHooks sets the restaking hooks provided by other modules,
+// running them in the alphabetical order of the providing modules' names.
+func InvokeSetRestakingHooks(
+	keeper *keeper.Keeper,
+	restakingHooks map[string]types.RestakingHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(restakingHooks) == 0 {
+		return nil
+	}
+
+	modNames := slices.Sorted(maps.Keys(restakingHooks))
+
+	var multiHooks types.MultiRestakingHooks
+	for _, modName := range modNames {
+		hook, ok := restakingHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find restaking hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
 	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
 }
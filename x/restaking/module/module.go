@@ -2,10 +2,18 @@ package module
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 
 	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
@@ -15,28 +23,113 @@ import (
 )
 
 type AppModule struct {
-	keeper keeper.Keeper
+	keeper        *keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 var _ appmodule.AppModule = AppModule{}
 var _ appmodule.HasServices = AppModule{}
 var _ appmodule.HasEndBlocker = AppModule{}
+var _ module.HasGenesis = AppModule{}
+var _ module.AppModuleBasic = AppModule{}
+var _ module.AppModuleSimulation = AppModule{}
+var _ module.HasInvariants = AppModule{}
+var _ module.HasConsensusVersion = AppModule{}
+var _ autocli.HasAutoCLIConfig = AppModule{}
 
-func NewAppModule(k keeper.Keeper) AppModule {
-	return AppModule{keeper: k}
+func NewAppModule(k *keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) AppModule {
+	return AppModule{
+		keeper:        k,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+	}
 }
 
 func (AppModule) IsAppModule() {}
 
 func (AppModule) Name() string { return types.ModuleName }
 
+// RegisterInterfaces registers the module's Msg implementations.
+func (AppModule) RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registrar)
+}
+
+// RegisterLegacyAminoCodec registers the amino codec
+func (AppModule) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := restakingv1.RegisterQueryHandlerClient(clientCtx.CmdContext, mux, restakingv1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	restakingv1.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	restakingv1.RegisterQueryServer(registrar, keeper.NewQueryServer(am.keeper))
+
+	// migrations can only be registered through the app's configurator
+	cfg, ok := registrar.(module.Configurator)
+	if !ok {
+		return nil
+	}
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
+	}
 	return nil
 }
 
+// ConsensusVersion is a sequence number for state-breaking change of the module.
+// It should be incremented on each consensus-breaking change introduced by the module.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// DefaultGenesis returns the default restaking genesis state as raw JSON.
+func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
+}
+
+// ValidateGenesis validates the restaking genesis state.
+func (AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState restakingv1.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return types.ValidateGenesis(genState)
+}
+
+// InitGenesis initializes the restaking state from genesis.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var genState restakingv1.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err))
+	}
+
+	if err := am.keeper.InitGenesis(ctx, genState); err != nil {
+		panic(fmt.Errorf("failed to initialize %s genesis state: %w", types.ModuleName, err))
+	}
+}
+
+// ExportGenesis returns the restaking state as raw JSON.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to export %s genesis state: %w", types.ModuleName, err))
+	}
+
+	return cdc.MustMarshalJSON(genState)
+}
+
+// RegisterInvariants registers the restaking invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, *am.keeper)
+}
+
 func (am AppModule) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	restaking.EndBlocker(sdkCtx, am.keeper)
-	return nil
+	return restaking.EndBlocker(sdkCtx, *am.keeper)
 }
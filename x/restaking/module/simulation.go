@@ -0,0 +1,161 @@
+package module
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	restakingsimulation "github.com/lyfeloopinc/lyfebloc-network/x/restaking/simulation"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	restakingsimulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for the module's collections.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema())
+}
+
+// WeightedOperations returns the all the restaking module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0)
+	const (
+		opWeightMsgWithdrawDelegatorReward          = "op_weight_msg_restaking_withdraw_delegator_reward"
+		defaultWeightMsgWithdrawDelegatorReward int = 100
+	)
+
+	var weightMsgWithdrawDelegatorReward int
+	simState.AppParams.GetOrGenerate(opWeightMsgWithdrawDelegatorReward, &weightMsgWithdrawDelegatorReward, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawDelegatorReward = defaultWeightMsgWithdrawDelegatorReward
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgWithdrawDelegatorReward,
+		restakingsimulation.SimulateMsgWithdrawDelegatorReward(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgSetValidatorOverride          = "op_weight_msg_restaking_set_validator_override"
+		defaultWeightMsgSetValidatorOverride int = 20
+	)
+
+	var weightMsgSetValidatorOverride int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetValidatorOverride, &weightMsgSetValidatorOverride, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetValidatorOverride = defaultWeightMsgSetValidatorOverride
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetValidatorOverride,
+		restakingsimulation.SimulateMsgSetValidatorOverride(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgClearValidatorOverride          = "op_weight_msg_restaking_clear_validator_override"
+		defaultWeightMsgClearValidatorOverride int = 10
+	)
+
+	var weightMsgClearValidatorOverride int
+	simState.AppParams.GetOrGenerate(opWeightMsgClearValidatorOverride, &weightMsgClearValidatorOverride, nil,
+		func(_ *rand.Rand) {
+			weightMsgClearValidatorOverride = defaultWeightMsgClearValidatorOverride
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClearValidatorOverride,
+		restakingsimulation.SimulateMsgClearValidatorOverride(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgSetDelegatorPreference          = "op_weight_msg_restaking_set_delegator_preference"
+		defaultWeightMsgSetDelegatorPreference int = 100
+	)
+
+	var weightMsgSetDelegatorPreference int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetDelegatorPreference, &weightMsgSetDelegatorPreference, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDelegatorPreference = defaultWeightMsgSetDelegatorPreference
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetDelegatorPreference,
+		restakingsimulation.SimulateMsgSetDelegatorPreference(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgClearDelegatorPreference          = "op_weight_msg_restaking_clear_delegator_preference"
+		defaultWeightMsgClearDelegatorPreference int = 30
+	)
+
+	var weightMsgClearDelegatorPreference int
+	simState.AppParams.GetOrGenerate(opWeightMsgClearDelegatorPreference, &weightMsgClearDelegatorPreference, nil,
+		func(_ *rand.Rand) {
+			weightMsgClearDelegatorPreference = defaultWeightMsgClearDelegatorPreference
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClearDelegatorPreference,
+		restakingsimulation.SimulateMsgClearDelegatorPreference(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgCancelRetry          = "op_weight_msg_restaking_cancel_retry"
+		defaultWeightMsgCancelRetry int = 10
+	)
+
+	var weightMsgCancelRetry int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelRetry, &weightMsgCancelRetry, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelRetry = defaultWeightMsgCancelRetry
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelRetry,
+		restakingsimulation.SimulateMsgCancelRetry(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgForceRetry          = "op_weight_msg_restaking_force_retry"
+		defaultWeightMsgForceRetry int = 10
+	)
+
+	var weightMsgForceRetry int
+	simState.AppParams.GetOrGenerate(opWeightMsgForceRetry, &weightMsgForceRetry, nil,
+		func(_ *rand.Rand) {
+			weightMsgForceRetry = defaultWeightMsgForceRetry
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgForceRetry,
+		restakingsimulation.SimulateMsgForceRetry(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgClaimCarryOver          = "op_weight_msg_restaking_claim_carry_over"
+		defaultWeightMsgClaimCarryOver int = 10
+	)
+
+	var weightMsgClaimCarryOver int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimCarryOver, &weightMsgClaimCarryOver, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimCarryOver = defaultWeightMsgClaimCarryOver
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimCarryOver,
+		restakingsimulation.SimulateMsgClaimCarryOver(am.accountKeeper, am.bankKeeper, am.stakingKeeper, *am.keeper, simState.TxConfig),
+	))
+
+	return operations
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
+	return restakingsimulation.ProposalMsgs()
+}
@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SimulateMsgClaimCarryOver claims one or all of the carry-overs of a random
+// simulation account that has any.
+func SimulateMsgClaimCarryOver(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgClaimCarryOver{})
+
+		qs := keeper.NewQueryServer(&k)
+		for _, simAccount := range shuffledAccounts(r, accs) {
+			res, err := qs.CarryOvers(ctx, &restakingv1.QueryCarryOversRequest{DelegatorAddress: simAccount.Address.String()})
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get carry-overs"), nil, err
+			}
+			if len(res.CarryOvers) == 0 {
+				continue
+			}
+
+			msg := &restakingv1.MsgClaimCarryOver{DelegatorAddress: simAccount.Address.String()}
+			if r.Intn(2) == 0 {
+				msg.ValidatorAddress = res.CarryOvers[r.Intn(len(res.CarryOvers))].ValidatorAddress
+			}
+			return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no carry-over to claim"), nil, nil
+	}
+}
@@ -0,0 +1,98 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SimulateMsgSetDelegatorPreference sets a random preference for a random
+// account, including per-validator entries, a fallback validator and
+// diversification targets drawn from the current validators.
+func SimulateMsgSetDelegatorPreference(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	_ keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgSetDelegatorPreference{})
+
+		validators, err := shuffledValidators(r, ctx, sk)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get validators"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &restakingv1.MsgSetDelegatorPreference{DelegatorAddress: simAccount.Address.String()}
+		switch r.Intn(3) {
+		case 0:
+			msg.Disabled = r.Intn(4) == 0
+		case 1:
+			ratio := genRatio(r)
+			msg.Ratio = &ratio
+		}
+
+		// the validators are shuffled, so each entry below picks distinct ones
+		numPrefs := min(r.Intn(3), len(validators))
+		for i := 0; i < numPrefs; i++ {
+			vp := restakingv1.ValidatorPreference{ValidatorAddress: validators[i].OperatorAddress}
+			if r.Intn(2) == 0 {
+				vp.Disabled = true
+			} else {
+				ratio := genRatio(r)
+				vp.Ratio = &ratio
+			}
+			msg.ValidatorPreferences = append(msg.ValidatorPreferences, vp)
+		}
+		if len(validators) > 0 && r.Intn(3) == 0 {
+			msg.FallbackValidatorAddress = validators[r.Intn(len(validators))].OperatorAddress
+		}
+		if r.Intn(3) == 0 {
+			numTargets := min(1+r.Intn(3), len(validators))
+			for i := 0; i < numTargets; i++ {
+				msg.DiversificationTargets = append(msg.DiversificationTargets, restakingv1.DiversificationTarget{
+					ValidatorAddress: validators[i].OperatorAddress,
+					Weight:           uint64(1 + r.Intn(10)),
+				})
+			}
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgClearDelegatorPreference clears the preference of a random
+// account that has one.
+func SimulateMsgClearDelegatorPreference(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgClearDelegatorPreference{})
+
+		for _, simAccount := range shuffledAccounts(r, accs) {
+			if _, found := k.GetDelegatorPreference(ctx, simAccount.Address); !found {
+				continue
+			}
+
+			msg := &restakingv1.MsgClearDelegatorPreference{DelegatorAddress: simAccount.Address.String()}
+			return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no delegator preference to clear"), nil, nil
+	}
+}
@@ -0,0 +1,105 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// Simulation parameter constants
+const (
+	AutoRestakeRatio     = "auto_restake_ratio"
+	DelegatorPreferences = "delegator_preferences"
+)
+
+// genRatio returns a random ratio between 0 and 1 in steps of 0.01.
+func genRatio(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// RandomParams returns valid restaking parameters with every field
+// randomized.
+func RandomParams(r *rand.Rand) restakingv1.Params {
+	minRatio, maxRatio := genRatio(r), genRatio(r)
+	if minRatio.GT(maxRatio) {
+		minRatio, maxRatio = maxRatio, minRatio
+	}
+
+	params := restakingv1.Params{
+		AutoRestakeRatio:         genRatio(r),
+		MinValidatorRatio:        minRatio,
+		MaxValidatorRatio:        maxRatio,
+		MaxRetryAttempts:         uint32(r.Intn(types.MaxRetryAttempts + 1)),
+		RetryBackoffBlocks:       uint64(1 + r.Intn(20)),
+		MaxRestakesPerBlock:      uint32(1 + r.Intn(1_000)),
+		MaxRestakeGasPerBlock:    uint64(1_000_000 + r.Intn(types.DefaultMaxRestakeGasPerBlock)),
+		UnhealthyValidatorPolicy: restakingv1.UnhealthyValidatorPolicy(1 + r.Intn(3)),
+		MaxValidatorCommission:   sdkmath.LegacyNewDecWithPrec(int64(50+r.Intn(51)), 2),
+		MinValidatorUptime:       sdkmath.LegacyZeroDec(),
+		MinRestakeAmount:         sdkmath.ZeroInt(),
+		NonBondDenomPolicy:       restakingv1.NonBondDenomPolicy(1 + r.Intn(3)),
+
+		FailedRetryRetentionBlocks: uint64(1 + r.Intn(1_000)),
+	}
+	if r.Intn(4) == 0 {
+		params.EpochIdentifier = types.EpochIdentifiers[r.Intn(len(types.EpochIdentifiers))]
+	}
+	if r.Intn(2) == 0 {
+		params.MinValidatorUptime = sdkmath.LegacyNewDecWithPrec(int64(r.Intn(96)), 2)
+	}
+	if r.Intn(2) == 0 {
+		params.MinRestakeAmount = sdkmath.NewInt(int64(r.Intn(10_000)))
+	}
+	return params
+}
+
+// RandomizedGenState generates a random GenesisState for restaking.
+func RandomizedGenState(simState *module.SimulationState) {
+	var ratio sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(
+		AutoRestakeRatio, &ratio, simState.Rand,
+		func(r *rand.Rand) { ratio = genRatio(r) },
+	)
+
+	// roughly a quarter of the accounts start with a preference of their own
+	var prefs []restakingv1.DelegatorPreference
+	simState.AppParams.GetOrGenerate(
+		DelegatorPreferences, &prefs, simState.Rand,
+		func(r *rand.Rand) {
+			for _, acc := range simState.Accounts {
+				if r.Intn(4) != 0 {
+					continue
+				}
+				pref := restakingv1.DelegatorPreference{DelegatorAddress: acc.Address.String()}
+				if r.Intn(5) == 0 {
+					pref.Disabled = true
+				} else {
+					prefRatio := genRatio(r)
+					pref.Ratio = &prefRatio
+				}
+				prefs = append(prefs, pref)
+			}
+		},
+	)
+
+	params := RandomParams(simState.Rand)
+	params.AutoRestakeRatio = ratio
+
+	restakingGenesis := types.DefaultGenesis()
+	restakingGenesis.Params = params
+	restakingGenesis.DelegatorPreferences = prefs
+
+	bz, err := json.MarshalIndent(&restakingGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated restaking parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(restakingGenesis)
+}
@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// deliver signs msg for simAccount with random fees and delivers it.
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         txGen,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	})
+}
+
+// shuffledValidators returns every validator in random order.
+func shuffledValidators(r *rand.Rand, ctx sdk.Context, sk types.StakingKeeper) ([]stakingtypes.Validator, error) {
+	validators, err := sk.GetAllValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.Shuffle(len(validators), func(i, j int) {
+		validators[i], validators[j] = validators[j], validators[i]
+	})
+	return validators, nil
+}
+
+// shuffledAccounts returns a copy of accs in random order.
+func shuffledAccounts(r *rand.Rand, accs []simtypes.Account) []simtypes.Account {
+	shuffled := make([]simtypes.Account, len(accs))
+	for i, j := range r.Perm(len(accs)) {
+		shuffled[i] = accs[j]
+	}
+	return shuffled
+}
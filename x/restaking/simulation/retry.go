@@ -0,0 +1,93 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// randomRetryEntry returns a random queued retry of a random simulation
+// account that has any.
+func randomRetryEntry(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, restakingv1.RetryEntry, bool, error) {
+	qs := keeper.NewQueryServer(&k)
+	for _, simAccount := range shuffledAccounts(r, accs) {
+		res, err := qs.RetryEntries(ctx, &restakingv1.QueryRetryEntriesRequest{DelegatorAddress: simAccount.Address.String()})
+		if err != nil {
+			return simtypes.Account{}, restakingv1.RetryEntry{}, false, err
+		}
+		if len(res.Entries) > 0 {
+			return simAccount, res.Entries[r.Intn(len(res.Entries))], true, nil
+		}
+	}
+	return simtypes.Account{}, restakingv1.RetryEntry{}, false, nil
+}
+
+// SimulateMsgCancelRetry cancels a random queued retry.
+func SimulateMsgCancelRetry(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgCancelRetry{})
+
+		simAccount, entry, found, err := randomRetryEntry(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get retry entries"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no retry to cancel"), nil, nil
+		}
+
+		msg := &restakingv1.MsgCancelRetry{
+			DelegatorAddress: simAccount.Address.String(),
+			Id:               entry.Id,
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgForceRetry forces a random queued retry that would succeed.
+func SimulateMsgForceRetry(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	_ types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgForceRetry{})
+
+		simAccount, entry, found, err := randomRetryEntry(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get retry entries"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no retry to force"), nil, nil
+		}
+
+		// a failing retry fails the transaction, so try it on a throwaway
+		// context first
+		cacheCtx, _ := ctx.CacheContext()
+		if err := k.ForceRetry(cacheCtx, simAccount.Address, entry); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "retry would fail"), nil, nil
+		}
+
+		msg := &restakingv1.MsgForceRetry{
+			DelegatorAddress: simAccount.Address.String(),
+			Id:               entry.Id,
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+	}
+}
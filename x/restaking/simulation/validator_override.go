@@ -0,0 +1,96 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SimulateMsgSetValidatorOverride sets a ratio within the governance bounds
+// for a random validator operated by a simulation account.
+func SimulateMsgSetValidatorOverride(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgSetValidatorOverride{})
+
+		validators, err := shuffledValidators(r, ctx, sk)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get validators"), nil, err
+		}
+		for _, val := range validators {
+			valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator address"), nil, err
+			}
+			simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+			if !found {
+				continue
+			}
+
+			params := k.GetParams(ctx)
+			ratio := params.MinValidatorRatio
+			if spread := params.MaxValidatorRatio.Sub(params.MinValidatorRatio); spread.IsPositive() {
+				ratio = ratio.Add(simtypes.RandomDecAmount(r, spread))
+			}
+
+			msg := &restakingv1.MsgSetValidatorOverride{
+				ValidatorAddress: valAddr.String(),
+				Ratio:            ratio,
+			}
+			return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no validator operated by a simulation account"), nil, nil
+	}
+}
+
+// SimulateMsgClearValidatorOverride clears the override of a random
+// validator operated by a simulation account.
+func SimulateMsgClearValidatorOverride(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&restakingv1.MsgClearValidatorOverride{})
+
+		validators, err := shuffledValidators(r, ctx, sk)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get validators"), nil, err
+		}
+		for _, val := range validators {
+			valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator address"), nil, err
+			}
+			if _, found := k.GetValidatorOverride(ctx, valAddr); !found {
+				continue
+			}
+			simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+			if !found {
+				continue
+			}
+
+			msg := &restakingv1.MsgClearValidatorOverride{ValidatorAddress: valAddr.String()}
+			return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no validator override to clear"), nil, nil
+	}
+}
@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/keeper"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+// SimulateMsgWithdrawDelegatorReward withdraws the rewards of a random
+// delegation of a random simulation account, for the module to auto-restake
+// them at end block. The module invariants are checked first, against the
+// state the restakes of the previous blocks left, so that the simulation
+// fails as soon as one breaks.
+func SimulateMsgWithdrawDelegatorReward(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{})
+
+		if msg, broken := keeper.AllInvariants(k)(ctx); broken {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invariant broken"), nil, errors.New(msg)
+		}
+
+		for _, simAccount := range shuffledAccounts(r, accs) {
+			delegations, err := sk.GetDelegatorDelegations(ctx, simAccount.Address, math.MaxUint16)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get delegations"), nil, err
+			}
+			if len(delegations) == 0 {
+				continue
+			}
+
+			delegation := delegations[r.Intn(len(delegations))]
+			msg := distributiontypes.NewMsgWithdrawDelegatorReward(simAccount.Address.String(), delegation.ValidatorAddress)
+			return deliver(r, app, ctx, txGen, ak, bk, simAccount, msg)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no delegation to withdraw from"), nil, nil
+	}
+}
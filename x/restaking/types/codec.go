@@ -1,14 +1,31 @@
 package types
 
 import (
-    "github.com/cosmos/cosmos-sdk/codec"
-    cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
 )
 
 func RegisterCodec(_ *codec.LegacyAmino) {}
-func RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+func RegisterInterfaces(registrar cdctypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&restakingv1.MsgUpdateParams{},
+		&restakingv1.MsgSetValidatorOverride{},
+		&restakingv1.MsgClearValidatorOverride{},
+		&restakingv1.MsgSetDelegatorPreference{},
+		&restakingv1.MsgClearDelegatorPreference{},
+		&restakingv1.MsgCancelRetry{},
+		&restakingv1.MsgForceRetry{},
+		&restakingv1.MsgClaimCarryOver{},
+	)
+	msgservice.RegisterMsgServiceDesc(registrar, &restakingv1.Msg_serviceDesc)
+}
 
 var (
-    Amino     = codec.NewLegacyAmino()
-    ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
 )
@@ -0,0 +1,25 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/restaking module sentinel errors
+var (
+	ErrInvalidSigner      = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidParams      = errors.Register(ModuleName, 1101, "invalid restaking params")
+	ErrInvalidAddress     = errors.Register(ModuleName, 1102, "invalid address")
+	ErrInvalidRatio       = errors.Register(ModuleName, 1103, "invalid auto-restake ratio")
+	ErrValidatorNotFound  = errors.Register(ModuleName, 1104, "validator not found")
+	ErrOverrideNotFound   = errors.Register(ModuleName, 1105, "validator override not found")
+	ErrInvalidPreference  = errors.Register(ModuleName, 1106, "invalid delegator preference")
+	ErrPreferenceNotFound = errors.Register(ModuleName, 1107, "delegator preference not found")
+	ErrRetryNotFound      = errors.Register(ModuleName, 1108, "retry entry not found")
+	ErrRetryFailed        = errors.Register(ModuleName, 1109, "auto-restake retry failed")
+	ErrValidatorUnhealthy = errors.Register(ModuleName, 1110, "validator is not eligible for auto-restake")
+	ErrCarryOverNotFound  = errors.Register(ModuleName, 1111, "carry-over not found")
+	ErrRetryObsolete      = errors.Register(ModuleName, 1112, "auto-restake retry no longer applies")
+	ErrCarryOverUnpaid    = errors.Register(ModuleName, 1113, "carry-over could not be paid back")
+)
@@ -5,12 +5,79 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 )
 
 // StakingKeeper defines the subset of staking keeper functionality required by restaking.
 type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Validator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error)
+	Delegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationI, error)
+	GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error)
+	GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.Delegation, error)
 	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt sdkmath.Int, status stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (sdkmath.LegacyDec, error)
 	BondDenom(ctx context.Context) (string, error)
+	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 }
+
+// AccountKeeper defines the account lookup used by the simulation.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the subset of bank keeper functionality required to hold
+// carry-overs in the module account, and the balance lookup used by the
+// simulation.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the subset of distribution keeper functionality
+// required to work out what a reward withdrawal pays out, to withdraw rewards
+// on a delegator's behalf and to forward them to the community pool.
+type DistributionKeeper interface {
+	WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	IncrementValidatorPeriod(ctx context.Context, val stakingtypes.ValidatorI) (uint64, error)
+	CalculateDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (sdk.DecCoins, error)
+	GetValidatorOutstandingRewardsCoins(ctx context.Context, val sdk.ValAddress) (sdk.DecCoins, error)
+	GetDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error)
+}
+
+// ERC20Keeper defines the subset of x/erc20 keeper functionality required to
+// convert rewards to the ERC20 token of their token pair.
+type ERC20Keeper interface {
+	ConvertCoin(ctx context.Context, msg *erc20types.MsgConvertCoin) (*erc20types.MsgConvertCoinResponse, error)
+}
+
+// SlashingKeeper defines the subset of slashing keeper functionality required
+// to judge a validator's uptime.
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx context.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error)
+	SignedBlocksWindow(ctx context.Context) (int64, error)
+}
+
+// RestakingHooks are called by the restaking module around every
+// auto-restake delegation, including retries and epoch compounding.
+type RestakingHooks interface {
+	// BeforeAutoRestake runs before amount is delegated. An error aborts the
+	// restake, which then fails like any other.
+	BeforeAutoRestake(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) error
+	// AfterAutoRestake runs once amount has been delegated. An error reverts the
+	// restake, which then fails like any other.
+	AfterAutoRestake(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) error
+	// AfterAutoRestakeFailed runs after a restake failed and was reverted.
+	// Errors are logged and otherwise ignored.
+	AfterAutoRestakeFailed(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins, reason error) error
+}
+
+// RestakingHooksWrapper is a wrapper for modules to inject RestakingHooks using depinject.
+type RestakingHooksWrapper struct{ RestakingHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (RestakingHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *restakingv1.GenesisState {
+	return &restakingv1.GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis performs basic genesis state validation returning an error upon any
+// failure.
+func ValidateGenesis(gs restakingv1.GenesisState) error {
+	if err := ValidateParams(gs.Params); err != nil {
+		return err
+	}
+
+	seenValidators := make(map[string]struct{}, len(gs.ValidatorOverrides))
+	for _, o := range gs.ValidatorOverrides {
+		if _, err := sdk.ValAddressFromBech32(o.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator override address %s: %w", o.ValidatorAddress, err)
+		}
+		if _, ok := seenValidators[o.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate validator override for %s", o.ValidatorAddress)
+		}
+		seenValidators[o.ValidatorAddress] = struct{}{}
+
+		if err := ValidateValidatorRatio(gs.Params, o.Ratio); err != nil {
+			return fmt.Errorf("validator override for %s: %w", o.ValidatorAddress, err)
+		}
+	}
+
+	seenDelegators := make(map[string]struct{}, len(gs.DelegatorPreferences))
+	for _, pref := range gs.DelegatorPreferences {
+		if err := ValidateDelegatorPreference(pref); err != nil {
+			return fmt.Errorf("delegator preference for %s: %w", pref.DelegatorAddress, err)
+		}
+		if _, ok := seenDelegators[pref.DelegatorAddress]; ok {
+			return fmt.Errorf("duplicate delegator preference for %s", pref.DelegatorAddress)
+		}
+		seenDelegators[pref.DelegatorAddress] = struct{}{}
+	}
+
+	historyLen := make(map[string]int)
+	for _, record := range gs.RestakeHistory {
+		if _, err := sdk.AccAddressFromBech32(record.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid restake record delegator address %s: %w", record.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid restake record validator address %s: %w", record.ValidatorAddress, err)
+		}
+		if err := record.Amount.Validate(); err != nil {
+			return fmt.Errorf("restake record of %s: %w", record.DelegatorAddress, err)
+		}
+
+		historyLen[record.DelegatorAddress]++
+		if historyLen[record.DelegatorAddress] > MaxRestakeHistory {
+			return fmt.Errorf("restake history of %s exceeds %d records", record.DelegatorAddress, MaxRestakeHistory)
+		}
+	}
+
+	delegatorTotal := sdkmath.ZeroInt()
+	seenDelegators = make(map[string]struct{}, len(gs.DelegatorStats))
+	for _, stats := range gs.DelegatorStats {
+		if _, err := sdk.AccAddressFromBech32(stats.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator stats address %s: %w", stats.DelegatorAddress, err)
+		}
+		if _, ok := seenDelegators[stats.DelegatorAddress]; ok {
+			return fmt.Errorf("duplicate delegator stats for %s", stats.DelegatorAddress)
+		}
+		seenDelegators[stats.DelegatorAddress] = struct{}{}
+
+		if stats.Restaked.IsNil() || !stats.Restaked.IsPositive() {
+			return fmt.Errorf("restaked amount of delegator %s must be positive", stats.DelegatorAddress)
+		}
+		delegatorTotal = delegatorTotal.Add(stats.Restaked)
+	}
+
+	validatorTotal := sdkmath.ZeroInt()
+	seenValidators = make(map[string]struct{}, len(gs.ValidatorStats))
+	for _, stats := range gs.ValidatorStats {
+		if _, err := sdk.ValAddressFromBech32(stats.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator stats address %s: %w", stats.ValidatorAddress, err)
+		}
+		if _, ok := seenValidators[stats.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate validator stats for %s", stats.ValidatorAddress)
+		}
+		seenValidators[stats.ValidatorAddress] = struct{}{}
+
+		if stats.Restaked.IsNil() || !stats.Restaked.IsPositive() {
+			return fmt.Errorf("restaked amount of validator %s must be positive", stats.ValidatorAddress)
+		}
+		validatorTotal = validatorTotal.Add(stats.Restaked)
+	}
+
+	// every restake is counted once for its delegator and once for its validator
+	if !delegatorTotal.Equal(validatorTotal) {
+		return fmt.Errorf("delegator stats total %s does not match validator stats total %s", delegatorTotal, validatorTotal)
+	}
+
+	seenRetries := make(map[uint64]struct{}, len(gs.RetryEntries))
+	for _, entry := range gs.RetryEntries {
+		if err := validateRetryEntry(entry, gs.NextRetryId); err != nil {
+			return fmt.Errorf("retry entry %d: %w", entry.Id, err)
+		}
+		if _, ok := seenRetries[entry.Id]; ok {
+			return fmt.Errorf("duplicate retry entry %d", entry.Id)
+		}
+		seenRetries[entry.Id] = struct{}{}
+	}
+
+	for _, w := range gs.DeferredWithdrawals {
+		if _, err := sdk.AccAddressFromBech32(w.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid deferred withdrawal delegator address %s: %w", w.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(w.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid deferred withdrawal validator address %s: %w", w.ValidatorAddress, err)
+		}
+		if err := w.Amount.Validate(); err != nil {
+			return fmt.Errorf("deferred withdrawal of %s: %w", w.DelegatorAddress, err)
+		}
+	}
+
+	seenCarryOvers := make(map[string]struct{}, len(gs.CarryOvers))
+	for _, c := range gs.CarryOvers {
+		if _, err := sdk.AccAddressFromBech32(c.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid carry-over delegator address %s: %w", c.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(c.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid carry-over validator address %s: %w", c.ValidatorAddress, err)
+		}
+		key := c.DelegatorAddress + "/" + c.ValidatorAddress
+		if _, ok := seenCarryOvers[key]; ok {
+			return fmt.Errorf("duplicate carry-over of %s from %s", c.DelegatorAddress, c.ValidatorAddress)
+		}
+		seenCarryOvers[key] = struct{}{}
+
+		if c.Amount.IsNil() || !c.Amount.IsPositive() {
+			return fmt.Errorf("carry-over of %s from %s must be positive", c.DelegatorAddress, c.ValidatorAddress)
+		}
+	}
+
+	seenShares := make(map[string]struct{}, len(gs.RestakedShares))
+	for _, r := range gs.RestakedShares {
+		if _, err := sdk.AccAddressFromBech32(r.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid restaked shares delegator address %s: %w", r.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid restaked shares validator address %s: %w", r.ValidatorAddress, err)
+		}
+		key := r.DelegatorAddress + "/" + r.ValidatorAddress
+		if _, ok := seenShares[key]; ok {
+			return fmt.Errorf("duplicate restaked shares of %s to %s", r.DelegatorAddress, r.ValidatorAddress)
+		}
+		seenShares[key] = struct{}{}
+
+		if r.Shares.IsNil() || !r.Shares.IsPositive() {
+			return fmt.Errorf("restaked shares of %s to %s must be positive", r.DelegatorAddress, r.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+func validateRetryEntry(entry restakingv1.RetryEntry, nextID uint64) error {
+	if entry.Id >= nextID {
+		return fmt.Errorf("id must be below the next retry id %d", nextID)
+	}
+	if _, err := sdk.AccAddressFromBech32(entry.Restake.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address %s: %w", entry.Restake.DelegatorAddress, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(entry.Restake.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", entry.Restake.ValidatorAddress, err)
+	}
+	if err := entry.Restake.Amount.Validate(); err != nil {
+		return err
+	}
+	if entry.Restake.Amount.IsZero() {
+		return fmt.Errorf("amount cannot be empty")
+	}
+
+	switch entry.Status {
+	case restakingv1.RetryStatus_RETRY_STATUS_PENDING:
+		if entry.NextAttemptHeight <= 0 {
+			return fmt.Errorf("pending entry must have a next attempt height")
+		}
+	case restakingv1.RetryStatus_RETRY_STATUS_FAILED:
+		if entry.PruneHeight <= 0 {
+			return fmt.Errorf("failed entry must have a prune height")
+		}
+	default:
+		return fmt.Errorf("invalid status %s", entry.Status)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+	"github.com/lyfeloopinc/lyfebloc-network/x/restaking/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	delegator := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+	half := sdkmath.LegacyMustNewDecFromStr("0.5")
+
+	tests := []struct {
+		desc     string
+		genState *restakingv1.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &restakingv1.GenesisState{
+				Params:               types.DefaultParams(),
+				ValidatorOverrides:   []restakingv1.ValidatorOverride{{ValidatorAddress: validator, Ratio: half}},
+				DelegatorPreferences: []restakingv1.DelegatorPreference{{DelegatorAddress: delegator, Ratio: &half}},
+			},
+			valid: true,
+		},
+		{
+			desc: "valid restake history",
+			genState: &restakingv1.GenesisState{
+				Params: types.DefaultParams(),
+				RestakeHistory: []restakingv1.RestakeRecord{{
+					DelegatorAddress: delegator,
+					ValidatorAddress: validator,
+					Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 10)),
+					Ratio:            half,
+					RatioSource:      restakingv1.RatioSource_RATIO_SOURCE_DELEGATOR_PREFERENCE,
+					Height:           7,
+				}},
+			},
+			valid: true,
+		},
+		{
+			desc: "restake record with invalid validator",
+			genState: &restakingv1.GenesisState{
+				Params:         types.DefaultParams(),
+				RestakeHistory: []restakingv1.RestakeRecord{{DelegatorAddress: delegator, ValidatorAddress: delegator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid restake stats",
+			genState: &restakingv1.GenesisState{
+				Params:         types.DefaultParams(),
+				DelegatorStats: []restakingv1.DelegatorRestakeStats{{DelegatorAddress: delegator, Restaked: sdkmath.NewInt(30)}},
+				ValidatorStats: []restakingv1.ValidatorRestakeStats{{ValidatorAddress: validator, Restaked: sdkmath.NewInt(30)}},
+			},
+			valid: true,
+		},
+		{
+			desc: "restake stats totals mismatch",
+			genState: &restakingv1.GenesisState{
+				Params:         types.DefaultParams(),
+				DelegatorStats: []restakingv1.DelegatorRestakeStats{{DelegatorAddress: delegator, Restaked: sdkmath.NewInt(30)}},
+				ValidatorStats: []restakingv1.ValidatorRestakeStats{{ValidatorAddress: validator, Restaked: sdkmath.NewInt(20)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero delegator stats",
+			genState: &restakingv1.GenesisState{
+				Params:         types.DefaultParams(),
+				DelegatorStats: []restakingv1.DelegatorRestakeStats{{DelegatorAddress: delegator, Restaked: sdkmath.ZeroInt()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "retry entry id not below next retry id",
+			genState: &restakingv1.GenesisState{
+				Params: types.DefaultParams(),
+				RetryEntries: []restakingv1.RetryEntry{{
+					Id: 3,
+					Restake: restakingv1.RestakeRecord{
+						DelegatorAddress: delegator,
+						ValidatorAddress: validator,
+						Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 5)),
+					},
+					Status:            restakingv1.RetryStatus_RETRY_STATUS_PENDING,
+					NextAttemptHeight: 30,
+				}},
+				NextRetryId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "failed retry entry without prune height",
+			genState: &restakingv1.GenesisState{
+				Params: types.DefaultParams(),
+				RetryEntries: []restakingv1.RetryEntry{{
+					Id: 1,
+					Restake: restakingv1.RestakeRecord{
+						DelegatorAddress: delegator,
+						ValidatorAddress: validator,
+						Amount:           sdk.NewCoins(sdk.NewInt64Coin("ulbt", 5)),
+					},
+					Status: restakingv1.RetryStatus_RETRY_STATUS_FAILED,
+				}},
+				NextRetryId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc:     "empty params",
+			genState: &restakingv1.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "duplicate validator override",
+			genState: &restakingv1.GenesisState{
+				Params: types.DefaultParams(),
+				ValidatorOverrides: []restakingv1.ValidatorOverride{
+					{ValidatorAddress: validator, Ratio: half},
+					{ValidatorAddress: validator, Ratio: half},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "override outside bounds",
+			genState: &restakingv1.GenesisState{
+				Params:             types.DefaultParams(),
+				ValidatorOverrides: []restakingv1.ValidatorOverride{{ValidatorAddress: validator, Ratio: sdkmath.LegacyNewDec(2)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate delegator preference",
+			genState: &restakingv1.GenesisState{
+				Params: types.DefaultParams(),
+				DelegatorPreferences: []restakingv1.DelegatorPreference{
+					{DelegatorAddress: delegator, Disabled: true},
+					{DelegatorAddress: delegator, Disabled: true},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid carry-over",
+			genState: &restakingv1.GenesisState{
+				Params:     types.DefaultParams(),
+				CarryOvers: []restakingv1.CarryOver{{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: sdkmath.NewInt(3)}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate carry-over",
+			genState: &restakingv1.GenesisState{
+				Params: types.DefaultParams(),
+				CarryOvers: []restakingv1.CarryOver{
+					{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: sdkmath.NewInt(3)},
+					{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: sdkmath.NewInt(4)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid restaked shares",
+			genState: &restakingv1.GenesisState{
+				Params:         types.DefaultParams(),
+				RestakedShares: []restakingv1.RestakedShares{{DelegatorAddress: delegator, ValidatorAddress: validator, Shares: half}},
+			},
+			valid: true,
+		},
+		{
+			desc: "zero restaked shares",
+			genState: &restakingv1.GenesisState{
+				Params:         types.DefaultParams(),
+				RestakedShares: []restakingv1.RestakedShares{{DelegatorAddress: delegator, ValidatorAddress: validator, Shares: sdkmath.LegacyZeroDec()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero carry-over",
+			genState: &restakingv1.GenesisState{
+				Params:     types.DefaultParams(),
+				CarryOvers: []restakingv1.CarryOver{{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: sdkmath.ZeroInt()}},
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidateGenesis(*tc.genState)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple restaking hooks, all hook functions are run in array sequence
+var _ RestakingHooks = &MultiRestakingHooks{}
+
+type MultiRestakingHooks []RestakingHooks
+
+func NewMultiRestakingHooks(hooks ...RestakingHooks) MultiRestakingHooks {
+	return hooks
+}
+
+func (h MultiRestakingHooks) BeforeAutoRestake(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeAutoRestake(ctx, delegator, validator, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRestakingHooks) AfterAutoRestake(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterAutoRestake(ctx, delegator, validator, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRestakingHooks) AfterAutoRestakeFailed(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coins, reason error) error {
+	for i := range h {
+		if err := h[i].AfterAutoRestakeFailed(ctx, delegator, validator, amount, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
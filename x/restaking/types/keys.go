@@ -6,8 +6,41 @@ const (
 	ModuleName = "restaking"
 	StoreKey   = ModuleName
 	RouterKey  = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
 )
 
 var (
-	AutoRestakeRatioKey = collections.NewPrefix("auto_restake_ratio")
+	ParamsKey              = collections.NewPrefix("p_restaking")
+	ValidatorOverrideKey   = collections.NewPrefix("validator_override")
+	DelegatorPreferenceKey = collections.NewPrefix("delegator_preference")
+	RestakeHistoryKey      = collections.NewPrefix("restake_history")
+	HistorySequenceKey     = collections.NewPrefix("history_seq")
+	DelegatorStatsKey      = collections.NewPrefix("delegator_stats")
+	ValidatorStatsKey      = collections.NewPrefix("validator_stats")
+	TotalRestakedKey       = collections.NewPrefix("total_restaked")
+	ActiveRestakersKey     = collections.NewPrefix("active_restakers")
+	RetryEntryKey          = collections.NewPrefix("retry_entry")
+	RetryDueKey            = collections.NewPrefix("retry_due")
+	RetryPruneKey          = collections.NewPrefix("retry_prune")
+	RetrySequenceKey       = collections.NewPrefix("retry_seq")
+	DeferredWithdrawalKey  = collections.NewPrefix("deferred_withdrawal")
+	DeferredSequenceKey    = collections.NewPrefix("deferred_seq")
+	CarryOverKey           = collections.NewPrefix("carry_over")
+	RestakedSharesKey      = collections.NewPrefix("restaked_shares")
+
+	// PendingPayoutKey holds the reward payouts made by the transaction being
+	// executed. They live in the store so that they are reverted along with
+	// the message that made them; nothing is left behind once the transaction
+	// ends.
+	PendingPayoutKey = collections.NewPrefix("pending_payout")
+
+	// CompoundCursorKey holds the delegation the epoch compounding pass in
+	// progress resumes at, if any.
+	CompoundCursorKey = collections.NewPrefix("compound_cursor")
 )
+
+// MaxRestakeHistory is the number of auto-restakes kept per delegator. Older
+// records are pruned as new ones are added.
+const MaxRestakeHistory = 100
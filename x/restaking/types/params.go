@@ -2,18 +2,153 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
 )
 
 const (
 	DefaultAutoRestakeRatio = "0.25"
+
+	DefaultMaxRetryAttempts   = 5
+	DefaultRetryBackoffBlocks = 10
+
+	// DefaultFailedRetryRetentionBlocks keeps failed retries for about a week
+	// of 6 second blocks.
+	DefaultFailedRetryRetentionBlocks = 100_800
+
+	DefaultMaxRestakesPerBlock   = 500
+	DefaultMaxRestakeGasPerBlock = 100_000_000
+
+	// MaxRetryAttempts and MaxRetryBackoffBlocks bound the retry parameters so
+	// the longest backoff stays far from overflowing a block height.
+	MaxRetryAttempts      = 16
+	MaxRetryBackoffBlocks = 100_000
 )
 
+// EpochIdentifiers are the epochs governance may choose to compound on.
+var EpochIdentifiers = []string{"hour", "day", "week"}
+
 func DefaultAutoRestakeRatioDec() sdkmath.LegacyDec {
 	return sdkmath.LegacyMustNewDecFromStr(DefaultAutoRestakeRatio)
 }
 
+// DefaultParams returns the default restaking parameters.
+func DefaultParams() restakingv1.Params {
+	return restakingv1.Params{
+		AutoRestakeRatio:         DefaultAutoRestakeRatioDec(),
+		MinValidatorRatio:        sdkmath.LegacyZeroDec(),
+		MaxValidatorRatio:        sdkmath.LegacyOneDec(),
+		MaxRetryAttempts:         DefaultMaxRetryAttempts,
+		RetryBackoffBlocks:       DefaultRetryBackoffBlocks,
+		MaxRestakesPerBlock:      DefaultMaxRestakesPerBlock,
+		MaxRestakeGasPerBlock:    DefaultMaxRestakeGasPerBlock,
+		UnhealthyValidatorPolicy: restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_SKIP,
+		MaxValidatorCommission:   sdkmath.LegacyOneDec(),
+		MinValidatorUptime:       sdkmath.LegacyZeroDec(),
+		MinRestakeAmount:         sdkmath.ZeroInt(),
+		NonBondDenomPolicy:       restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_LEAVE_LIQUID,
+
+		FailedRetryRetentionBlocks: DefaultFailedRetryRetentionBlocks,
+	}
+}
+
+// ValidateParams performs stateless validation of the restaking parameters.
+func ValidateParams(p restakingv1.Params) error {
+	if p.AutoRestakeRatio.IsNil() {
+		return fmt.Errorf("auto restake ratio cannot be nil")
+	}
+	if err := ValidateAutoRestakeRatio(p.AutoRestakeRatio); err != nil {
+		return err
+	}
+	if p.MinValidatorRatio.IsNil() || p.MaxValidatorRatio.IsNil() {
+		return fmt.Errorf("validator ratio bounds cannot be nil")
+	}
+	if err := ValidateAutoRestakeRatio(p.MinValidatorRatio); err != nil {
+		return fmt.Errorf("min validator ratio: %w", err)
+	}
+	if err := ValidateAutoRestakeRatio(p.MaxValidatorRatio); err != nil {
+		return fmt.Errorf("max validator ratio: %w", err)
+	}
+	if p.MinValidatorRatio.GT(p.MaxValidatorRatio) {
+		return fmt.Errorf("min validator ratio %s exceeds max validator ratio %s", p.MinValidatorRatio, p.MaxValidatorRatio)
+	}
+	if p.EpochIdentifier != "" && !slices.Contains(EpochIdentifiers, p.EpochIdentifier) {
+		return fmt.Errorf("epoch identifier %q must be one of %v", p.EpochIdentifier, EpochIdentifiers)
+	}
+	if p.MaxRetryAttempts > MaxRetryAttempts {
+		return fmt.Errorf("max retry attempts %d exceeds %d", p.MaxRetryAttempts, MaxRetryAttempts)
+	}
+	if p.MaxRetryAttempts > 0 && (p.RetryBackoffBlocks == 0 || p.RetryBackoffBlocks > MaxRetryBackoffBlocks) {
+		return fmt.Errorf("retry backoff blocks must be between 1 and %d", MaxRetryBackoffBlocks)
+	}
+	if p.FailedRetryRetentionBlocks == 0 {
+		return fmt.Errorf("failed retry retention blocks must be positive")
+	}
+	if p.MaxRestakesPerBlock == 0 {
+		return fmt.Errorf("max restakes per block must be positive")
+	}
+	if p.MaxRestakeGasPerBlock == 0 {
+		return fmt.Errorf("max restake gas per block must be positive")
+	}
+	if _, ok := restakingv1.UnhealthyValidatorPolicy_name[int32(p.UnhealthyValidatorPolicy)]; !ok ||
+		p.UnhealthyValidatorPolicy == restakingv1.UnhealthyValidatorPolicy_UNHEALTHY_VALIDATOR_POLICY_UNSPECIFIED {
+		return fmt.Errorf("invalid unhealthy validator policy %s", p.UnhealthyValidatorPolicy)
+	}
+	if p.MaxValidatorCommission.IsNil() || p.MinValidatorUptime.IsNil() {
+		return fmt.Errorf("validator health thresholds cannot be nil")
+	}
+	if p.MaxValidatorCommission.IsNegative() || p.MaxValidatorCommission.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("max validator commission must be between 0 and 1")
+	}
+	if p.MinValidatorUptime.IsNegative() || p.MinValidatorUptime.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("min validator uptime must be between 0 and 1")
+	}
+	if p.MinRestakeAmount.IsNil() || p.MinRestakeAmount.IsNegative() {
+		return fmt.Errorf("min restake amount cannot be negative")
+	}
+	if err := validateNonBondDenomPolicy(p.NonBondDenomPolicy); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(p.DenomPolicies))
+	for _, dp := range p.DenomPolicies {
+		if err := sdk.ValidateDenom(dp.Denom); err != nil {
+			return fmt.Errorf("denom policy: %w", err)
+		}
+		if seen[dp.Denom] {
+			return fmt.Errorf("duplicate denom policy for %s", dp.Denom)
+		}
+		seen[dp.Denom] = true
+		if err := validateNonBondDenomPolicy(dp.Policy); err != nil {
+			return fmt.Errorf("denom policy for %s: %w", dp.Denom, err)
+		}
+	}
+	return nil
+}
+
+func validateNonBondDenomPolicy(policy restakingv1.NonBondDenomPolicy) error {
+	if _, ok := restakingv1.NonBondDenomPolicy_name[int32(policy)]; !ok ||
+		policy == restakingv1.NonBondDenomPolicy_NON_BOND_DENOM_POLICY_UNSPECIFIED {
+		return fmt.Errorf("invalid non-bond denom policy %s", policy)
+	}
+	return nil
+}
+
+// ValidateValidatorRatio checks that a validator override lies within the
+// governance bounds.
+func ValidateValidatorRatio(p restakingv1.Params, r sdkmath.LegacyDec) error {
+	if r.IsNil() {
+		return fmt.Errorf("validator ratio cannot be nil")
+	}
+	if r.LT(p.MinValidatorRatio) || r.GT(p.MaxValidatorRatio) {
+		return fmt.Errorf("validator ratio %s must be between %s and %s", r, p.MinValidatorRatio, p.MaxValidatorRatio)
+	}
+	return nil
+}
+
 func ValidateAutoRestakeRatio(r sdkmath.LegacyDec) error {
 	if r.IsNegative() || r.GT(sdkmath.LegacyOneDec()) {
 		return fmt.Errorf("auto restake ratio must be between 0 and 1")
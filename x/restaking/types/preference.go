@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingv1 "github.com/lyfeloopinc/lyfebloc-network/lyfeblocnetwork/restaking/v1"
+)
+
+// MaxValidatorPreferences bounds the per-validator entries a delegator may store.
+const MaxValidatorPreferences = 100
+
+// MaxDiversificationTargets bounds the validators a restake may be split across.
+const MaxDiversificationTargets = 20
+
+// ValidateDelegatorPreference performs stateless validation of a delegator preference.
+func ValidateDelegatorPreference(pref restakingv1.DelegatorPreference) error {
+	if _, err := sdk.AccAddressFromBech32(pref.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address: %w", err)
+	}
+	if pref.Ratio != nil {
+		if err := ValidateAutoRestakeRatio(*pref.Ratio); err != nil {
+			return err
+		}
+	}
+	if pref.FallbackValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(pref.FallbackValidatorAddress); err != nil {
+			return fmt.Errorf("invalid fallback validator address: %w", err)
+		}
+	}
+
+	if len(pref.ValidatorPreferences) > MaxValidatorPreferences {
+		return fmt.Errorf("too many validator preferences: %d > %d", len(pref.ValidatorPreferences), MaxValidatorPreferences)
+	}
+
+	seen := make(map[string]struct{}, len(pref.ValidatorPreferences))
+	for _, vp := range pref.ValidatorPreferences {
+		if _, err := sdk.ValAddressFromBech32(vp.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address: %w", err)
+		}
+		if _, ok := seen[vp.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate preference for validator %s", vp.ValidatorAddress)
+		}
+		seen[vp.ValidatorAddress] = struct{}{}
+
+		if vp.Disabled == (vp.Ratio != nil) {
+			return fmt.Errorf("preference for validator %s must set exactly one of disabled or ratio", vp.ValidatorAddress)
+		}
+		if vp.Ratio != nil {
+			if err := ValidateAutoRestakeRatio(*vp.Ratio); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(pref.DiversificationTargets) > MaxDiversificationTargets {
+		return fmt.Errorf("too many diversification targets: %d > %d", len(pref.DiversificationTargets), MaxDiversificationTargets)
+	}
+
+	seen = make(map[string]struct{}, len(pref.DiversificationTargets))
+	for _, target := range pref.DiversificationTargets {
+		if _, err := sdk.ValAddressFromBech32(target.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid diversification target address: %w", err)
+		}
+		if _, ok := seen[target.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate diversification target %s", target.ValidatorAddress)
+		}
+		seen[target.ValidatorAddress] = struct{}{}
+
+		if target.Weight == 0 {
+			return fmt.Errorf("weight of diversification target %s must be positive", target.ValidatorAddress)
+		}
+	}
+
+	return nil
+}